@@ -0,0 +1,205 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// conversionDataAnnotation holds the v1beta1 fields v1alpha1 cannot represent,
+// so that they survive a round trip through v1alpha1.
+const conversionDataAnnotation = OperatorKeyPrefix + "conversion-data"
+
+// conversionData are the v1beta1 fields kept in conversionDataAnnotation.
+type conversionData struct {
+	ServiceAccounts       []v1beta1.ServiceAccount       `json:"serviceAccounts,omitempty"`
+	Teardown              *v1beta1.TeardownStatus        `json:"teardown,omitempty"`
+	ServiceAccountsStatus []v1beta1.ServiceAccountStatus `json:"serviceAccountsStatus,omitempty"`
+	Clusters              *v1beta1.ClustersStatus        `json:"clusters,omitempty"`
+}
+
+func (d conversionData) isEmpty() bool {
+	return len(d.ServiceAccounts) == 0 && d.Teardown == nil && len(d.ServiceAccountsStatus) == 0 &&
+		d.Clusters == nil
+}
+
+// ConvertTo converts this Organization to the hub version (v1beta1).
+func (src *Organization) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.Organization)
+	src = src.DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = v1beta1.OrganizationSpec{
+		Parent:        src.Spec.Parent,
+		DisplayName:   src.Spec.DisplayName,
+		Description:   src.Spec.Description,
+		OwnerEmails:   src.Spec.OwnerEmails,
+		ContactEmails: src.Spec.ContactEmails,
+		SupportTier:   v1beta1.SupportTier(src.Spec.SupportTier),
+		Namespace: v1beta1.NamespaceSpec{
+			Labels:           src.Spec.NamespaceLabels,
+			Annotations:      src.Spec.NamespaceAnnotations,
+			DeletionPolicy:   v1beta1.DeletionPolicy(src.Spec.DeletionPolicy),
+			NetworkIsolation: v1beta1.NetworkIsolation(src.Spec.NetworkIsolation),
+			ResourceQuota:    src.Spec.ResourceQuota,
+			LimitRange:       src.Spec.LimitRange,
+		},
+		Suspended: src.Spec.Suspended,
+	}
+	for _, id := range src.Spec.CustomerIDs {
+		dst.Spec.CustomerIDs = append(dst.Spec.CustomerIDs, v1beta1.CustomerID(id))
+	}
+	for _, binding := range src.Spec.Access {
+		converted := v1beta1.AccessBinding{
+			ClusterRole: binding.ClusterRole,
+			Groups:      binding.Groups,
+			Users:       binding.Users,
+		}
+		for _, serviceAccount := range binding.ServiceAccounts {
+			converted.ServiceAccounts = append(converted.ServiceAccounts, v1beta1.ServiceAccountReference(serviceAccount))
+		}
+		dst.Spec.Access = append(dst.Spec.Access, converted)
+	}
+	if podSecurity := src.Spec.PodSecurity; podSecurity != nil {
+		dst.Spec.Namespace.PodSecurity = &v1beta1.PodSecurity{
+			Enforce:        v1beta1.PodSecurityLevel(podSecurity.Enforce),
+			EnforceVersion: podSecurity.EnforceVersion,
+			Audit:          v1beta1.PodSecurityLevel(podSecurity.Audit),
+			AuditVersion:   podSecurity.AuditVersion,
+			Warn:           v1beta1.PodSecurityLevel(podSecurity.Warn),
+			WarnVersion:    podSecurity.WarnVersion,
+		}
+	}
+	dst.Status = v1beta1.OrganizationStatus{
+		Namespace:             src.Status.Namespace,
+		NamespaceAdoptionTime: src.Status.NamespaceAdoptionTime,
+		ResourceQuota:         src.Status.ResourceQuota,
+		AccessRoleBindings:    src.Status.AccessRoleBindings,
+		ObservedGeneration:    src.Status.ObservedGeneration,
+		Conditions:            src.Status.Conditions,
+	}
+
+	return restoreConversionData(dst)
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this Organization.
+func (dst *Organization) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.Organization).DeepCopy()
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec = OrganizationSpec{
+		Parent:               src.Spec.Parent,
+		DisplayName:          src.Spec.DisplayName,
+		Description:          src.Spec.Description,
+		OwnerEmails:          src.Spec.OwnerEmails,
+		ContactEmails:        src.Spec.ContactEmails,
+		SupportTier:          SupportTier(src.Spec.SupportTier),
+		NamespaceLabels:      src.Spec.Namespace.Labels,
+		NamespaceAnnotations: src.Spec.Namespace.Annotations,
+		DeletionPolicy:       DeletionPolicy(src.Spec.Namespace.DeletionPolicy),
+		ResourceQuota:        src.Spec.Namespace.ResourceQuota,
+		LimitRange:           src.Spec.Namespace.LimitRange,
+		NetworkIsolation:     NetworkIsolation(src.Spec.Namespace.NetworkIsolation),
+		Suspended:            src.Spec.Suspended,
+	}
+	for _, id := range src.Spec.CustomerIDs {
+		dst.Spec.CustomerIDs = append(dst.Spec.CustomerIDs, CustomerID(id))
+	}
+	for _, binding := range src.Spec.Access {
+		converted := AccessBinding{
+			ClusterRole: binding.ClusterRole,
+			Groups:      binding.Groups,
+			Users:       binding.Users,
+		}
+		for _, serviceAccount := range binding.ServiceAccounts {
+			converted.ServiceAccounts = append(converted.ServiceAccounts, ServiceAccountReference(serviceAccount))
+		}
+		dst.Spec.Access = append(dst.Spec.Access, converted)
+	}
+	if podSecurity := src.Spec.Namespace.PodSecurity; podSecurity != nil {
+		dst.Spec.PodSecurity = &PodSecurity{
+			Enforce:        PodSecurityLevel(podSecurity.Enforce),
+			EnforceVersion: podSecurity.EnforceVersion,
+			Audit:          PodSecurityLevel(podSecurity.Audit),
+			AuditVersion:   podSecurity.AuditVersion,
+			Warn:           PodSecurityLevel(podSecurity.Warn),
+			WarnVersion:    podSecurity.WarnVersion,
+		}
+	}
+	dst.Status = OrganizationStatus{
+		Namespace:             src.Status.Namespace,
+		NamespaceAdoptionTime: src.Status.NamespaceAdoptionTime,
+		ResourceQuota:         src.Status.ResourceQuota,
+		AccessRoleBindings:    src.Status.AccessRoleBindings,
+		ObservedGeneration:    src.Status.ObservedGeneration,
+		Conditions:            src.Status.Conditions,
+	}
+
+	return preserveConversionData(src, dst)
+}
+
+// preserveConversionData stores the fields of src that dst cannot represent in
+// the conversion data annotation of dst.
+func preserveConversionData(src *v1beta1.Organization, dst *Organization) error {
+	data := conversionData{
+		ServiceAccounts:       src.Spec.ServiceAccounts,
+		Teardown:              src.Status.Teardown,
+		ServiceAccountsStatus: src.Status.ServiceAccounts,
+		Clusters:              src.Status.Clusters,
+	}
+	if data.isEmpty() {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conversion data: %w", err)
+	}
+	if dst.Annotations == nil {
+		dst.Annotations = map[string]string{}
+	}
+	dst.Annotations[conversionDataAnnotation] = string(raw)
+	return nil
+}
+
+// restoreConversionData restores the fields kept in the conversion data
+// annotation of dst and removes the annotation.
+func restoreConversionData(dst *v1beta1.Organization) error {
+	raw, ok := dst.Annotations[conversionDataAnnotation]
+	if !ok {
+		return nil
+	}
+	delete(dst.Annotations, conversionDataAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	data := conversionData{}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return fmt.Errorf("failed to unmarshal conversion data: %w", err)
+	}
+	dst.Spec.ServiceAccounts = data.ServiceAccounts
+	dst.Status.Teardown = data.Teardown
+	dst.Status.ServiceAccounts = data.ServiceAccountsStatus
+	dst.Status.Clusters = data.Clusters
+	return nil
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+
+	"github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+func fullOrganization() *Organization {
+	return &Organization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "acme",
+			Labels:      map[string]string{"team": "platform"},
+			Annotations: map[string]string{AllowDeletionAnnotation: "true"},
+		},
+		Spec: OrganizationSpec{
+			Parent:        "holding",
+			DisplayName:   "ACME Corp.",
+			Description:   "Makers of everything.",
+			OwnerEmails:   []string{"owner@acme.example"},
+			ContactEmails: []string{"oncall@acme.example"},
+			SupportTier:   SupportTierPremium,
+			CustomerIDs:   []CustomerID{{System: "crm", ID: "42"}},
+			NamespaceLabels: map[string]string{
+				"team": "platform",
+			},
+			NamespaceAnnotations: map[string]string{
+				"example.com/cost-center": "1234",
+			},
+			DeletionPolicy: DeletionPolicyRetain,
+			ResourceQuota: &corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+			},
+			LimitRange: &corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{{
+					Type:    corev1.LimitTypeContainer,
+					Default: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+				}},
+			},
+			Access: []AccessBinding{{
+				ClusterRole:     "admin",
+				Groups:          []string{"acme-admins"},
+				Users:           []string{"jane@acme.example"},
+				ServiceAccounts: []ServiceAccountReference{{Name: "deployer", Namespace: "ci"}},
+			}},
+			NetworkIsolation: NetworkIsolationStrict,
+			Suspended:        true,
+			PodSecurity: &PodSecurity{
+				Enforce:        PodSecurityLevelBaseline,
+				EnforceVersion: "v1.31",
+				Audit:          PodSecurityLevelRestricted,
+				AuditVersion:   "latest",
+				Warn:           PodSecurityLevelRestricted,
+				WarnVersion:    "latest",
+			},
+		},
+		Status: OrganizationStatus{
+			Namespace:          "org-acme",
+			AccessRoleBindings: []string{"organization-access-admin"},
+			ObservedGeneration: 3,
+			Conditions: []metav1.Condition{{
+				Type:   ConditionReady,
+				Status: metav1.ConditionTrue,
+				Reason: ReasonReconciled,
+			}},
+		},
+	}
+}
+
+var _ = ginkgo.Describe("Organization conversion", func() {
+	ginkgo.It("is served by the conversion webhook", func() {
+		scheme := runtime.NewScheme()
+		gomega.Expect(AddToScheme(scheme)).To(gomega.Succeed())
+		gomega.Expect(v1beta1.AddToScheme(scheme)).To(gomega.Succeed())
+
+		gomega.Expect(conversion.IsConvertible(scheme, &v1beta1.Organization{})).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("round-trips a v1alpha1 Organization through the hub", func() {
+		original := fullOrganization()
+
+		hub := &v1beta1.Organization{}
+		gomega.Expect(original.ConvertTo(hub)).To(gomega.Succeed())
+		gomega.Expect(hub.Spec.Namespace.Labels).To(gomega.Equal(original.Spec.NamespaceLabels))
+		gomega.Expect(hub.Spec.Namespace.DeletionPolicy).To(gomega.Equal(v1beta1.DeletionPolicyRetain))
+		gomega.Expect(hub.Spec.Namespace.PodSecurity.Enforce).To(gomega.Equal(v1beta1.PodSecurityLevelBaseline))
+
+		converted := &Organization{}
+		gomega.Expect(converted.ConvertFrom(hub)).To(gomega.Succeed())
+		gomega.Expect(converted).To(gomega.Equal(original))
+	})
+
+	ginkgo.It("round-trips a v1beta1 Organization through v1alpha1", func() {
+		hub := &v1beta1.Organization{}
+		gomega.Expect(fullOrganization().ConvertTo(hub)).To(gomega.Succeed())
+		hub.Status.Teardown = &v1beta1.TeardownStatus{
+			Stage:              v1beta1.TeardownStageDeletingResources,
+			RemainingResources: []string{"Cluster/acme-prod"},
+		}
+		hub.Spec.ServiceAccounts = []v1beta1.ServiceAccount{{
+			Name:         "ci",
+			ClusterRoles: []string{"edit"},
+			Token:        true,
+		}}
+		hub.Status.ServiceAccounts = []v1beta1.ServiceAccountStatus{{Name: "ci", TokenSecret: "ci-token"}}
+		hub.Status.Clusters = &v1beta1.ClustersStatus{
+			Count: 1,
+			Items: []v1beta1.ClusterSummary{{Name: "acme-prod", Phase: "Provisioned"}},
+		}
+		original := hub.DeepCopy()
+
+		spoke := &Organization{}
+		gomega.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		gomega.Expect(spoke.Annotations).To(gomega.HaveKey(conversionDataAnnotation))
+		converted := &v1beta1.Organization{}
+		gomega.Expect(spoke.ConvertTo(converted)).To(gomega.Succeed())
+		gomega.Expect(converted).To(gomega.Equal(original))
+	})
+
+	ginkgo.It("round-trips an empty Organization", func() {
+		original := &Organization{ObjectMeta: metav1.ObjectMeta{Name: "empty"}}
+
+		hub := &v1beta1.Organization{}
+		gomega.Expect(original.ConvertTo(hub)).To(gomega.Succeed())
+		converted := &Organization{}
+		gomega.Expect(converted.ConvertFrom(hub)).To(gomega.Succeed())
+		gomega.Expect(converted).To(gomega.Equal(original))
+	})
+
+	ginkgo.It("does not share memory with the source", func() {
+		original := fullOrganization()
+
+		hub := &v1beta1.Organization{}
+		gomega.Expect(original.ConvertTo(hub)).To(gomega.Succeed())
+		hub.Spec.Namespace.Labels["team"] = "changed"
+		hub.Spec.Access[0].Groups[0] = "changed"
+
+		gomega.Expect(original).To(gomega.Equal(fullOrganization()))
+	})
+})
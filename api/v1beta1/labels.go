@@ -0,0 +1,85 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import "strings"
+
+const (
+	// OrganizationLabel is set on the organization namespace to the name of
+	// the owning Organization.
+	OrganizationLabel = "giantswarm.io/organization"
+	// ManagedByLabel is set on every object created by organization-operator.
+	ManagedByLabel = "giantswarm.io/managed-by"
+	// ManagedByValue is the value of ManagedByLabel for objects created by
+	// organization-operator.
+	ManagedByValue = "organization-operator"
+	// OperatorKeyPrefix prefixes the labels and annotations organization-operator
+	// uses for its own bookkeeping.
+	OperatorKeyPrefix = "organization.giantswarm.io/"
+
+	// AllowDeletionAnnotation allows deleting an organization whose namespace
+	// still contains protected resources when set to "true".
+	AllowDeletionAnnotation = OperatorKeyPrefix + "allow-deletion"
+
+	// AdoptNamespaceAnnotation allows an organization to take over an existing
+	// namespace not managed by organization-operator when set to "true".
+	AdoptNamespaceAnnotation = OperatorKeyPrefix + "adopt-namespace"
+
+	// MigrateNamespaceAnnotation moves an organization to the namespace
+	// rendered from the current naming template when set to "true". Without
+	// it, organizations keep the namespace recorded in their status.
+	MigrateNamespaceAnnotation = OperatorKeyPrefix + "migrate-namespace"
+
+	// SuspendedLabel is set to "true" on the namespace of a suspended
+	// organization.
+	SuspendedLabel = OperatorKeyPrefix + "suspended"
+
+	// DisplayNameAnnotation, DescriptionAnnotation, OwnerEmailsAnnotation,
+	// ContactEmailsAnnotation, SupportTierAnnotation and CustomerIDsAnnotation
+	// mirror the display metadata of the organization on its namespace. Lists
+	// are comma-separated; customer IDs are formatted as system=id.
+	DisplayNameAnnotation   = OperatorKeyPrefix + "display-name"
+	DescriptionAnnotation   = OperatorKeyPrefix + "description"
+	OwnerEmailsAnnotation   = OperatorKeyPrefix + "owner-emails"
+	ContactEmailsAnnotation = OperatorKeyPrefix + "contact-emails"
+	SupportTierAnnotation   = OperatorKeyPrefix + "support-tier"
+	CustomerIDsAnnotation   = OperatorKeyPrefix + "customer-ids"
+
+	// ReplicateAnnotation marks a Secret or ConfigMap in the replication
+	// namespace for copying into organization namespaces when set to "true".
+	ReplicateAnnotation = OperatorKeyPrefix + "replicate"
+	// ReplicateSelectorAnnotation restricts the replication of a Secret or
+	// ConfigMap to the organization namespaces matching the label selector.
+	ReplicateSelectorAnnotation = OperatorKeyPrefix + "replicate-selector"
+	// ReplicatedFromAnnotation is set on copies of replicated Secrets and
+	// ConfigMaps to the namespace/name of their source.
+	ReplicatedFromAnnotation = OperatorKeyPrefix + "replicated-from"
+
+	// PodSecurityLabelPrefix prefixes the Pod Security Admission namespace
+	// labels rendered from spec.podSecurity.
+	PodSecurityLabelPrefix = "pod-security.kubernetes.io/"
+)
+
+// IsReservedNamespaceKey reports whether a namespace label or annotation key
+// is owned by organization-operator and cannot be set through the spec.
+func IsReservedNamespaceKey(key string) bool {
+	switch key {
+	case OrganizationLabel, ManagedByLabel:
+		return true
+	}
+	return strings.HasPrefix(key, OperatorKeyPrefix)
+}
@@ -0,0 +1,543 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeletionPolicy defines what happens to the organization namespace when the
+// organization is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the namespace and everything in it.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyRetain keeps the namespace and releases it from the
+	// operator: the ownerReference and the managed-by label are removed.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyOrphan keeps the namespace with its labels but removes
+	// the ownerReference. An Organization of the same name created later
+	// takes it over only when annotated with
+	// organization.giantswarm.io/adopt-namespace=true.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// NetworkIsolation selects the NetworkPolicies rendered into the organization
+// namespace.
+// +kubebuilder:validation:Enum=None;Baseline;Strict
+type NetworkIsolation string
+
+const (
+	// NetworkIsolationNone does not create any NetworkPolicy.
+	NetworkIsolationNone NetworkIsolation = "None"
+	// NetworkIsolationBaseline denies ingress by default and allows it from
+	// the organization namespace and the management cluster components.
+	NetworkIsolationBaseline NetworkIsolation = "Baseline"
+	// NetworkIsolationStrict additionally denies egress by default and allows
+	// it to the organization namespace, the management cluster components and
+	// cluster DNS.
+	NetworkIsolationStrict NetworkIsolation = "Strict"
+)
+
+// PodSecurityLevel is a Pod Security Standards level.
+// +kubebuilder:validation:Enum=privileged;baseline;restricted
+type PodSecurityLevel string
+
+const (
+	// PodSecurityLevelPrivileged is unrestricted.
+	PodSecurityLevelPrivileged PodSecurityLevel = "privileged"
+	// PodSecurityLevelBaseline prevents known privilege escalations.
+	PodSecurityLevelBaseline PodSecurityLevel = "baseline"
+	// PodSecurityLevelRestricted follows pod hardening best practices.
+	PodSecurityLevelRestricted PodSecurityLevel = "restricted"
+)
+
+// PodSecurity configures Pod Security Admission for the organization namespace.
+// Each level and version is set as the matching pod-security.kubernetes.io
+// namespace label; unset ones leave the cluster defaults in effect.
+type PodSecurity struct {
+	// Enforce is the level pods are rejected for violating.
+	// +optional
+	Enforce PodSecurityLevel `json:"enforce,omitempty"`
+
+	// EnforceVersion is the Kubernetes minor version, e.g. v1.31, or latest,
+	// of the enforced level.
+	// +kubebuilder:validation:Pattern=`^(latest|v[0-9]+\.[0-9]+)$`
+	// +optional
+	EnforceVersion string `json:"enforceVersion,omitempty"`
+
+	// Audit is the level whose violations are recorded in the audit log.
+	// +optional
+	Audit PodSecurityLevel `json:"audit,omitempty"`
+
+	// AuditVersion is the Kubernetes minor version of the audited level.
+	// +kubebuilder:validation:Pattern=`^(latest|v[0-9]+\.[0-9]+)$`
+	// +optional
+	AuditVersion string `json:"auditVersion,omitempty"`
+
+	// Warn is the level whose violations are returned as warnings to users.
+	// +optional
+	Warn PodSecurityLevel `json:"warn,omitempty"`
+
+	// WarnVersion is the Kubernetes minor version of the warned level.
+	// +kubebuilder:validation:Pattern=`^(latest|v[0-9]+\.[0-9]+)$`
+	// +optional
+	WarnVersion string `json:"warnVersion,omitempty"`
+}
+
+// AccessBinding grants a ClusterRole in the organization namespace to a set of
+// subjects.
+type AccessBinding struct {
+	// ClusterRole is the name of the ClusterRole granted in the organization
+	// namespace, e.g. admin or view.
+	// +kubebuilder:validation:MinLength=1
+	ClusterRole string `json:"clusterRole"`
+
+	// Groups are the names of the (OIDC) groups granted the role.
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// Users are the names of the users granted the role.
+	// +optional
+	Users []string `json:"users,omitempty"`
+
+	// ServiceAccounts are the service accounts granted the role.
+	// +optional
+	ServiceAccounts []ServiceAccountReference `json:"serviceAccounts,omitempty"`
+}
+
+// ServiceAccount is a ServiceAccount provisioned in the organization
+// namespace.
+type ServiceAccount struct {
+	// Name of the ServiceAccount.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// ClusterRoles are granted to the ServiceAccount in the organization
+	// namespace. While the organization is suspended, only read-only
+	// ClusterRoles stay bound.
+	// +listType=set
+	// +optional
+	ClusterRoles []string `json:"clusterRoles,omitempty"`
+
+	// Token requests a long-lived token for the ServiceAccount, stored in the
+	// Secret <name>-token of the organization namespace.
+	// +optional
+	Token bool `json:"token,omitempty"`
+}
+
+// ServiceAccountStatus reports a ServiceAccount provisioned in the
+// organization namespace.
+type ServiceAccountStatus struct {
+	// Name of the ServiceAccount.
+	Name string `json:"name"`
+
+	// TokenSecret is the name of the Secret holding the token of the
+	// ServiceAccount, if one was requested.
+	// +optional
+	TokenSecret string `json:"tokenSecret,omitempty"`
+}
+
+// ServiceAccountReference references a ServiceAccount.
+type ServiceAccountReference struct {
+	// Name of the ServiceAccount.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace of the ServiceAccount. Defaults to the organization namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// SupportTier is the support contract of an organization.
+// +kubebuilder:validation:Enum=Basic;Standard;Premium
+type SupportTier string
+
+const (
+	// SupportTierBasic is the basic support contract.
+	SupportTierBasic SupportTier = "Basic"
+	// SupportTierStandard is the standard support contract.
+	SupportTierStandard SupportTier = "Standard"
+	// SupportTierPremium is the premium support contract.
+	SupportTierPremium SupportTier = "Premium"
+)
+
+// CustomerID identifies the organization in an external system.
+type CustomerID struct {
+	// System is the name of the external system, e.g. a CRM or billing system.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	System string `json:"system"`
+
+	// ID is the identifier of the organization in the external system.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	ID string `json:"id"`
+}
+
+// OrganizationSpec defines the desired state of Organization
+type OrganizationSpec struct {
+	// Parent is the name of the parent Organization. The organization inherits
+	// the access bindings and namespace labels of its ancestors, and their
+	// ResourceQuota hard limits act as ceilings for its own.
+	// +optional
+	Parent string `json:"parent,omitempty"`
+
+	// DisplayName is the human-readable name of the organization.
+	// +kubebuilder:validation:MaxLength=128
+	// +optional
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description describes the organization.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// OwnerEmails are the email addresses of the owners of the organization.
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:items:Format=email
+	// +listType=set
+	// +optional
+	OwnerEmails []string `json:"ownerEmails,omitempty"`
+
+	// ContactEmails are the email addresses to contact about the organization,
+	// e.g. for incidents or maintenance.
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:items:Format=email
+	// +listType=set
+	// +optional
+	ContactEmails []string `json:"contactEmails,omitempty"`
+
+	// SupportTier is the support contract of the organization.
+	// +optional
+	SupportTier SupportTier `json:"supportTier,omitempty"`
+
+	// CustomerIDs identify the organization in external systems.
+	// +listType=map
+	// +listMapKey=system
+	// +optional
+	CustomerIDs []CustomerID `json:"customerIDs,omitempty"`
+
+	// Namespace configures the organization namespace and the resources
+	// managed in it.
+	// +kubebuilder:default={}
+	// +optional
+	Namespace NamespaceSpec `json:"namespace,omitempty"`
+
+	// Access lists the ClusterRoles granted in the organization namespace.
+	// Each entry is materialized as a RoleBinding; RoleBindings of entries
+	// removed from the list are deleted.
+	// +optional
+	// +listType=map
+	// +listMapKey=clusterRole
+	Access []AccessBinding `json:"access,omitempty"`
+
+	// ServiceAccounts are provisioned in the organization namespace, e.g. for
+	// CI systems, and bound to ClusterRoles. ServiceAccounts removed from the
+	// list are deleted together with their RoleBindings and tokens.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ServiceAccounts []ServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// Suspended freezes the organization without deleting any data: its
+	// ResourceQuota is scaled to zero, RoleBindings of ClusterRoles that are
+	// not read-only are removed and the namespace is labelled as suspended.
+	// Unsuspending restores the state described by the spec.
+	// +optional
+	Suspended bool `json:"suspended,omitempty"`
+}
+
+// NamespaceSpec configures the organization namespace.
+type NamespaceSpec struct {
+	// Labels are additional labels set on the organization namespace. The
+	// giantswarm.io/organization and giantswarm.io/managed-by labels are
+	// always set by the operator and cannot be overridden.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are additional annotations set on the organization
+	// namespace.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// DeletionPolicy defines what happens to the organization namespace when
+	// the organization is deleted. Defaults to Delete. Retain and Orphan keep
+	// the namespace; a later Organization takes it over only when annotated
+	// with organization.giantswarm.io/adopt-namespace=true.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// PodSecurity sets the Pod Security Admission labels of the organization
+	// namespace.
+	// +optional
+	PodSecurity *PodSecurity `json:"podSecurity,omitempty"`
+
+	// NetworkIsolation selects the NetworkPolicies managed in the organization
+	// namespace.
+	// +kubebuilder:default=None
+	// +optional
+	NetworkIsolation NetworkIsolation `json:"networkIsolation,omitempty"`
+
+	// ResourceQuota is materialized as a ResourceQuota in the organization
+	// namespace. The ResourceQuota is removed when unset.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+
+	// LimitRange is materialized as a LimitRange in the organization
+	// namespace. The LimitRange is removed when unset.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
+}
+
+// Condition types reported in OrganizationStatus.Conditions.
+const (
+	// ConditionReady indicates whether the organization is fully reconciled.
+	ConditionReady = "Ready"
+	// ConditionNamespaceReady indicates whether the organization namespace
+	// exists and matches the desired state.
+	ConditionNamespaceReady = "NamespaceReady"
+	// ConditionDeleting indicates whether the organization is being deleted.
+	ConditionDeleting = "Deleting"
+	// ConditionSuspended indicates whether the organization is suspended.
+	ConditionSuspended = "Suspended"
+	// ConditionDeletionStuck indicates whether the deletion of the
+	// organization takes longer than the deletion timeout.
+	ConditionDeletionStuck = "DeletionStuck"
+)
+
+// Condition reasons reported in OrganizationStatus.Conditions.
+const (
+	ReasonReconciled              = "Reconciled"
+	ReasonFinalizerFailed         = "FinalizerFailed"
+	ReasonNamespaceReconciled     = "NamespaceReconciled"
+	ReasonNamespaceFailed         = "NamespaceFailed"
+	ReasonNotDeleting             = "NotDeleting"
+	ReasonNamespaceDeleting       = "NamespaceDeleting"
+	ReasonNamespaceDeletionFailed = "NamespaceDeletionFailed"
+	ReasonFinalizerRemovalFailed  = "FinalizerRemovalFailed"
+	ReasonDeletionBlocked         = "DeletionBlocked"
+	ReasonNamespaceRetained       = "NamespaceRetained"
+	ReasonNamespaceOrphaned       = "NamespaceOrphaned"
+	ReasonNamespaceReleaseFailed  = "NamespaceReleaseFailed"
+	ReasonNamespaceAdopted        = "NamespaceAdopted"
+	ReasonNamespaceConflict       = "NamespaceConflict"
+	ReasonDriftCorrected          = "DriftCorrected"
+	ReasonFieldConflict           = "FieldConflict"
+	ReasonResourceQuotaFailed     = "ResourceQuotaFailed"
+	ReasonLimitRangeFailed        = "LimitRangeFailed"
+	ReasonAccessFailed            = "AccessFailed"
+	ReasonNetworkPolicyFailed     = "NetworkPolicyFailed"
+	ReasonNamespaceMigrated       = "NamespaceMigrated"
+	ReasonMigrationBlocked        = "NamespaceMigrationBlocked"
+	ReasonSuspended               = "Suspended"
+	ReasonNotSuspended            = "NotSuspended"
+	ReasonParentNotFound          = "ParentNotFound"
+	ReasonParentCycle             = "ParentCycle"
+	ReasonHasChildren             = "HasChildren"
+	ReasonDeletingResources       = "DeletingResources"
+	ReasonDeletionInProgress      = "DeletionInProgress"
+	ReasonDeletionTimeoutExceeded = "DeletionTimeoutExceeded"
+	ReasonDeletionStarted         = "DeletionStarted"
+	ReasonReplicationFailed       = "ReplicationFailed"
+	ReasonServiceAccountsFailed   = "ServiceAccountsFailed"
+	ReasonClusterInventoryFailed  = "ClusterInventoryFailed"
+)
+
+// Event reasons emitted for Organizations in addition to the condition reasons.
+const (
+	ReasonNamespaceCreated  = "NamespaceCreated"
+	ReasonNamespaceUpdated  = "NamespaceUpdated"
+	ReasonFinalizerMigrated = "FinalizerMigrated"
+	ReasonDeletionCompleted = "DeletionCompleted"
+	ReasonReconcileFailed   = "ReconcileFailed"
+	ReasonReplicaConflict   = "ReplicaConflict"
+	// ReasonServiceAccountConflict reports a ServiceAccount, RoleBinding or
+	// token Secret of spec.serviceAccounts that exists but is not controlled
+	// by the organization.
+	ReasonServiceAccountConflict = "ServiceAccountConflict"
+)
+
+// TeardownStage is a stage of the teardown of a deleted organization.
+type TeardownStage string
+
+const (
+	// TeardownStageBlocked waits for protected resources to be removed from
+	// the organization namespace.
+	TeardownStageBlocked TeardownStage = "Blocked"
+	// TeardownStageDeletingResources waits for the Cluster API clusters, Apps
+	// and other teardown kinds in the organization namespace to be deleted.
+	TeardownStageDeletingResources TeardownStage = "DeletingResources"
+	// TeardownStageDeletingNamespace waits for the organization namespace to
+	// be deleted.
+	TeardownStageDeletingNamespace TeardownStage = "DeletingNamespace"
+)
+
+// TeardownStatus reports the progress of the teardown of a deleted
+// organization.
+type TeardownStatus struct {
+	// Stage is the current stage of the teardown.
+	Stage TeardownStage `json:"stage"`
+
+	// RemainingResources lists the objects the current stage waits for, as
+	// Kind/name.
+	// +optional
+	RemainingResources []string `json:"remainingResources,omitempty"`
+}
+
+// ClustersStatus summarizes the Cluster API clusters in the organization
+// namespace.
+type ClustersStatus struct {
+	// Count is the number of clusters.
+	Count int32 `json:"count"`
+
+	// Items lists the clusters by name.
+	// +optional
+	Items []ClusterSummary `json:"items,omitempty"`
+}
+
+// ClusterSummary reports a Cluster API cluster in the organization namespace.
+type ClusterSummary struct {
+	// Name of the Cluster.
+	Name string `json:"name"`
+
+	// Phase of the Cluster, e.g. Provisioning or Provisioned.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+}
+
+// OrganizationStatus defines the observed state of Organization
+type OrganizationStatus struct {
+	// Namespace is the namespace containing the resources for this organization.
+	Namespace string `json:"namespace,omitempty"`
+
+	// NamespaceAdoptionTime is the time the operator adopted an existing
+	// namespace for this organization. It is unset when the operator created
+	// the namespace itself.
+	// +optional
+	NamespaceAdoptionTime *metav1.Time `json:"namespaceAdoptionTime,omitempty"`
+
+	// ResourceQuota reports the hard limits and usage of the organization
+	// ResourceQuota as of the last reconciliation. Changes of the usage alone
+	// do not trigger one.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaStatus `json:"resourceQuota,omitempty"`
+
+	// AccessRoleBindings lists the RoleBindings applied in the organization
+	// namespace for spec.access.
+	// +optional
+	AccessRoleBindings []string `json:"accessRoleBindings,omitempty"`
+
+	// ServiceAccounts lists the ServiceAccounts provisioned in the
+	// organization namespace for spec.serviceAccounts.
+	// +optional
+	ServiceAccounts []ServiceAccountStatus `json:"serviceAccounts,omitempty"`
+
+	// Clusters summarizes the Cluster API clusters in the organization
+	// namespace. It is unset when Cluster API is not installed.
+	// +optional
+	Clusters *ClustersStatus `json:"clusters,omitempty"`
+
+	// Teardown reports the progress of the teardown once the organization is
+	// deleted.
+	// +optional
+	Teardown *TeardownStatus `json:"teardown,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions describe the current state of the organization.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//nolint:revive
+//+kubebuilder:object:root=true
+//nolint:revive
+//+kubebuilder:subresource:status
+//nolint:revive
+//+kubebuilder:storageversion
+//nolint:revive
+//+kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName"
+//nolint:revive
+//+kubebuilder:printcolumn:name="Namespace",type="string",JSONPath=".status.namespace"
+//nolint:revive
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+//nolint:revive
+//+kubebuilder:printcolumn:name="Clusters",type="integer",JSONPath=".status.clusters.count"
+//nolint:revive
+//+kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Deleting",type="string",JSONPath=".status.conditions[?(@.type==\"Deleting\")].status",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Teardown",type="string",JSONPath=".status.teardown.stage",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Parent",type="string",JSONPath=".spec.parent",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Suspended",type="boolean",JSONPath=".spec.suspended",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Support Tier",type="string",JSONPath=".spec.supportTier",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Owners",type="string",JSONPath=".spec.ownerEmails",priority=1
+//nolint:revive
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+//nolint:revive
+//+kubebuilder:resource:scope=Cluster,categories={common,giantswarm},shortName={org,orgs}
+
+// Organization represents schema for managed Kubernetes namespace.
+// Reconciled by organization-operator.
+type Organization struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationSpec   `json:"spec,omitempty"`
+	Status OrganizationStatus `json:"status,omitempty"`
+}
+
+//nolint:revive
+//+kubebuilder:object:root=true
+
+// OrganizationList contains a list of Organization
+type OrganizationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Organization `json:"items"`
+}
+
+// GetDeletionPolicy returns the deletion policy of the organization,
+// defaulting to DeletionPolicyDelete.
+func (o *Organization) GetDeletionPolicy() DeletionPolicy {
+	if o.Spec.Namespace.DeletionPolicy == "" {
+		return DeletionPolicyDelete
+	}
+	return o.Spec.Namespace.DeletionPolicy
+}
+
+// Hub marks Organization as the conversion hub.
+func (*Organization) Hub() {}
+
+func init() {
+	SchemeBuilder.Register(&Organization{}, &OrganizationList{})
+}
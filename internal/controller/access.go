@@ -0,0 +1,109 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+const (
+	accessComponent       = "access"
+	accessRoleBindingName = "organization-access-"
+)
+
+// DefaultReadOnlyClusterRoles are the ClusterRoles of spec.access that remain
+// bound while an organization is suspended.
+var DefaultReadOnlyClusterRoles = []string{"view"}
+
+// reconcileAccess applies one RoleBinding per spec.access entry in
+// the organization namespace and deletes the RoleBindings of entries that were
+// removed. Bindings inherited from the ancestors are merged in per
+// ClusterRole. While the organization is suspended, only ClusterRoles listed in
+// ReadOnlyClusterRoles stay bound. The applied RoleBindings are reported in
+// the status.
+func (r *OrganizationReconciler) reconcileAccess(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string, inherited []securityv1beta1.AccessBinding) error { //nolint:lll
+	desired := map[string]bool{}
+	applied := make([]string, 0, len(organization.Spec.Access))
+	defer func() {
+		sort.Strings(applied)
+		organization.Status.AccessRoleBindings = applied
+	}()
+
+	bindings := organization.Spec.DeepCopy().Access
+	for _, access := range inherited {
+		bindings = mergeAccess(bindings, access)
+	}
+
+	for _, access := range bindings {
+		if organization.Spec.Suspended && !slices.Contains(r.ReadOnlyClusterRoles, access.ClusterRole) {
+			continue
+		}
+		roleBinding := &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      accessRoleBindingName + access.ClusterRole,
+				Namespace: namespaceName,
+			},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     access.ClusterRole,
+			},
+			Subjects: accessSubjects(access, namespaceName),
+		}
+		setManagedLabels(&roleBinding.ObjectMeta, organization)
+		roleBinding.Labels[componentLabel] = accessComponent
+		desired[roleBinding.Name] = true
+
+		if err := r.applyOwned(ctx, organization, roleBinding); err != nil {
+			return err
+		}
+		applied = append(applied, roleBinding.Name)
+	}
+
+	return r.pruneOwned(ctx, organization, &rbacv1.RoleBindingList{}, namespaceName, accessComponent, desired)
+}
+
+// accessSubjects returns the RoleBinding subjects of an access entry. Service
+// accounts without a namespace refer to the organization namespace.
+func accessSubjects(access securityv1beta1.AccessBinding, namespaceName string) []rbacv1.Subject {
+	subjects := make([]rbacv1.Subject, 0, len(access.Groups)+len(access.Users)+len(access.ServiceAccounts))
+	for _, group := range access.Groups {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group})
+	}
+	for _, user := range access.Users {
+		subjects = append(subjects, rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: user})
+	}
+	for _, serviceAccount := range access.ServiceAccounts {
+		namespace := serviceAccount.Namespace
+		if namespace == "" {
+			namespace = namespaceName
+		}
+		subjects = append(subjects, rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      serviceAccount.Name,
+			Namespace: namespace,
+		})
+	}
+	return subjects
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/protection"
+)
+
+// reconcileClusters summarizes the Cluster API clusters in the organization
+// namespace in the status. The summary is removed when Cluster API is not
+// installed. Clusters are read from the cache as unstructured objects, which
+// requires the client to cache unstructured objects.
+func (r *OrganizationReconciler) reconcileClusters(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(protection.ClusterKind.GroupVersion().WithKind(protection.ClusterKind.Kind + "List"))
+	if err := r.List(ctx, list, client.InNamespace(namespaceName)); err != nil {
+		if meta.IsNoMatchError(err) {
+			organization.Status.Clusters = nil
+			return nil
+		}
+		return fmt.Errorf("failed to list %s in namespace %s: %w", protection.ClusterKind.Kind, namespaceName, err)
+	}
+
+	clusters := &securityv1beta1.ClustersStatus{
+		Count: int32(len(list.Items)), //nolint:gosec // a namespace cannot hold that many clusters
+		Items: make([]securityv1beta1.ClusterSummary, 0, len(list.Items)),
+	}
+	for _, item := range list.Items {
+		phase, _, _ := unstructured.NestedString(item.Object, "status", "phase")
+		clusters.Items = append(clusters.Items, securityv1beta1.ClusterSummary{Name: item.GetName(), Phase: phase})
+	}
+	sort.Slice(clusters.Items, func(i, j int) bool { return clusters.Items[i].Name < clusters.Items[j].Name })
+	organization.Status.Clusters = clusters
+	return nil
+}
+
+// clusterRequests maps a Cluster to the organization owning its namespace.
+func (r *OrganizationReconciler) clusterRequests(ctx context.Context, cluster client.Object) []reconcile.Request {
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: cluster.GetNamespace()}, namespace); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.FromContext(ctx).Error(err, "Failed to get the namespace of a cluster", "namespace", cluster.GetNamespace())
+		}
+		return nil
+	}
+	if namespace.Labels[securityv1beta1.ManagedByLabel] != securityv1beta1.ManagedByValue {
+		return nil
+	}
+	name := namespace.Labels[securityv1beta1.OrganizationLabel]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+}
+
+// clusterChangedPredicate passes Cluster creations and deletions, and updates
+// that change the phase of a Cluster.
+func clusterChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*unstructured.Unstructured)
+			if !ok {
+				return false
+			}
+			newCluster, ok := e.ObjectNew.(*unstructured.Unstructured)
+			if !ok {
+				return false
+			}
+			oldPhase, _, _ := unstructured.NestedString(oldCluster.Object, "status", "phase")
+			newPhase, _, _ := unstructured.NestedString(newCluster.Object, "status", "phase")
+			return oldPhase != newPhase
+		},
+	}
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/hierarchy"
+)
+
+// missingParentRequeueAfter is how long to wait before looking for a missing
+// parent organization again.
+const missingParentRequeueAfter = time.Minute
+
+// inheritance is what an organization inherits from its ancestors.
+type inheritance struct {
+	// access are the access bindings of the ancestors, merged per ClusterRole.
+	access []securityv1beta1.AccessBinding
+	// namespaceLabels are the namespace labels of the ancestors, the nearest
+	// ancestor winning.
+	namespaceLabels map[string]string
+	// quotaCeiling is the lowest hard limit per resource among the
+	// ResourceQuotas of the ancestors.
+	quotaCeiling corev1.ResourceList
+}
+
+// resolveInheritance collects what the organization inherits from its
+// ancestors. A missing parent or a cycle marks the organization as not ready.
+func (r *OrganizationReconciler) resolveInheritance(ctx context.Context, organization *securityv1beta1.Organization) (inheritance, ctrl.Result, error) { //nolint:lll
+	ancestors, err := hierarchy.Ancestors(ctx, r.Client, organization)
+	switch {
+	case apierrors.IsNotFound(err):
+		message := fmt.Sprintf("Parent organization %s does not exist", organization.Spec.Parent)
+		log.FromContext(ctx).Info("Parent organization not found", "parent", organization.Spec.Parent)
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonParentNotFound, message)
+		return inheritance{}, ctrl.Result{RequeueAfter: missingParentRequeueAfter}, nil
+	case errors.Is(err, hierarchy.ErrCycle):
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonParentCycle, err.Error())
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonParentCycle,
+			"Reconcile", "%s", err.Error())
+		return inheritance{}, ctrl.Result{}, reconcile.TerminalError(err)
+	case err != nil:
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonParentNotFound, err.Error())
+		return inheritance{}, ctrl.Result{}, err
+	}
+	return inherit(ancestors), ctrl.Result{}, nil
+}
+
+// inherit merges the settings of the ancestors, given nearest first.
+func inherit(ancestors []securityv1beta1.Organization) inheritance {
+	inherited := inheritance{
+		namespaceLabels: map[string]string{},
+		quotaCeiling:    corev1.ResourceList{},
+	}
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		maps.Copy(inherited.namespaceLabels, ancestors[i].Spec.Namespace.Labels)
+	}
+
+	for _, ancestor := range ancestors {
+		for _, access := range ancestor.Spec.Access {
+			// Service accounts of an ancestor default to its own namespace
+			access = *access.DeepCopy()
+			for i := range access.ServiceAccounts {
+				if access.ServiceAccounts[i].Namespace == "" {
+					access.ServiceAccounts[i].Namespace = ancestor.Status.Namespace
+				}
+			}
+			inherited.access = mergeAccess(inherited.access, access)
+		}
+
+		if ancestor.Spec.Namespace.ResourceQuota == nil {
+			continue
+		}
+		for name, limit := range ancestor.Spec.Namespace.ResourceQuota.Hard {
+			if ceiling, ok := inherited.quotaCeiling[name]; !ok || limit.Cmp(ceiling) < 0 {
+				inherited.quotaCeiling[name] = limit.DeepCopy()
+			}
+		}
+	}
+	return inherited
+}
+
+// mergeAccess adds the subjects of access to the binding of the same
+// ClusterRole in bindings, or appends access when there is none.
+func mergeAccess(bindings []securityv1beta1.AccessBinding, access securityv1beta1.AccessBinding) []securityv1beta1.AccessBinding { //nolint:lll
+	i := slices.IndexFunc(bindings, func(binding securityv1beta1.AccessBinding) bool {
+		return binding.ClusterRole == access.ClusterRole
+	})
+	if i < 0 {
+		return append(bindings, *access.DeepCopy())
+	}
+
+	binding := &bindings[i]
+	for _, group := range access.Groups {
+		if !slices.Contains(binding.Groups, group) {
+			binding.Groups = append(binding.Groups, group)
+		}
+	}
+	for _, user := range access.Users {
+		if !slices.Contains(binding.Users, user) {
+			binding.Users = append(binding.Users, user)
+		}
+	}
+	for _, serviceAccount := range access.ServiceAccounts {
+		if !slices.Contains(binding.ServiceAccounts, serviceAccount) {
+			binding.ServiceAccounts = append(binding.ServiceAccounts, serviceAccount)
+		}
+	}
+	return bindings
+}
+
+// applyQuotaCeiling lowers the hard limits of spec to the ceiling, and adds the
+// limits of the ceiling spec does not set.
+func applyQuotaCeiling(spec *corev1.ResourceQuotaSpec, ceiling corev1.ResourceList) *corev1.ResourceQuotaSpec {
+	if len(ceiling) == 0 {
+		return spec
+	}
+	capped := &corev1.ResourceQuotaSpec{}
+	if spec != nil {
+		capped = spec.DeepCopy()
+	}
+	if capped.Hard == nil {
+		capped.Hard = corev1.ResourceList{}
+	}
+	for name, limit := range ceiling {
+		if current, ok := capped.Hard[name]; !ok || current.Cmp(limit) > 0 {
+			capped.Hard[name] = limit.DeepCopy()
+		}
+	}
+	return capped
+}
+
+// checkChildren blocks the deletion of an organization that still has
+// children.
+func (r *OrganizationReconciler) checkChildren(ctx context.Context, organization *securityv1beta1.Organization) (ctrl.Result, error) { //nolint:lll
+	children, err := hierarchy.Children(ctx, r.Client, organization.Name)
+	if err != nil {
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+			securityv1beta1.ReasonNamespaceDeletionFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+	if len(children) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	message := fmt.Sprintf("Organization still has children %s", strings.Join(children, ", "))
+	log.FromContext(ctx).Info("Organization deletion blocked", "children", children)
+	blocking := make([]string, 0, len(children))
+	for _, child := range children {
+		blocking = append(blocking, "Organization/"+child)
+	}
+	setTeardown(organization, securityv1beta1.TeardownStageBlocked, blocking)
+	setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+		securityv1beta1.ReasonHasChildren, message)
+	r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonHasChildren,
+		"Delete", "%s", message)
+	return ctrl.Result{RequeueAfter: deletionBlockedRequeueAfter}, nil
+}
+
+// descendantRequests enqueues the descendants of an organization, which
+// inherit from it.
+func (r *OrganizationReconciler) descendantRequests(ctx context.Context, object client.Object) []reconcile.Request {
+	descendants, err := hierarchy.Descendants(ctx, r.Client, object.GetName())
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list descendant organizations", "organization", object.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(descendants))
+	for _, name := range descendants {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	}
+	return requests
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// collectTimeout bounds how long a scrape waits for the Organization cache.
+const collectTimeout = 10 * time.Second
+
+// Reconcile phases, as reported in the phase label.
+const (
+	phaseFinalizer = "finalizer"
+	phaseNamespace = "namespace"
+	phaseStatus    = "status"
+	phaseDelete    = "delete"
+)
+
+// Reconcile phase outcomes, as reported in the outcome label.
+const (
+	outcomeSuccess = "success"
+	outcomeRequeue = "requeue"
+	outcomeError   = "error"
+)
+
+var (
+	namespaceDriftCorrectedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "organization_namespace_drift_corrected_total",
+			Help: "The total number of times drift on an organization namespace was corrected",
+		},
+	)
+	organizationDeletionStuck = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "organization_deletion_stuck",
+			Help: "Whether the deletion of an organization takes longer than the deletion timeout",
+		},
+		[]string{"organization"},
+	)
+	reconcilePhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "organization_reconcile_phase_duration_seconds",
+			Help:    "The duration of the phases of organization reconciles",
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{"phase", "outcome"},
+	)
+	reconcilePhaseTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "organization_reconcile_phase_total",
+			Help: "The total number of organization reconcile phases run",
+		},
+		[]string{"phase", "outcome"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(namespaceDriftCorrectedTotal, organizationDeletionStuck,
+		reconcilePhaseDuration, reconcilePhaseTotal)
+}
+
+// observePhase records the duration since start and the outcome of a
+// reconcile phase. Phases that have to be retried later count as requeued.
+func observePhase(phase string, start time.Time, result ctrl.Result, err error) {
+	outcome := outcomeSuccess
+	switch {
+	case err != nil:
+		outcome = outcomeError
+	case !result.IsZero():
+		outcome = outcomeRequeue
+	}
+	reconcilePhaseDuration.WithLabelValues(phase, outcome).Observe(time.Since(start).Seconds())
+	reconcilePhaseTotal.WithLabelValues(phase, outcome).Inc()
+}
+
+var (
+	organizationsTotalDesc = prometheus.NewDesc(
+		"organizations_total",
+		"The total number of existing organizations",
+		nil, nil,
+	)
+	organizationsByConditionDesc = prometheus.NewDesc(
+		"organizations_by_condition",
+		"The number of organizations by status of their Ready, Deleting and Suspended conditions",
+		[]string{"condition", "status"}, nil,
+	)
+	organizationDeletionRequestedDesc = prometheus.NewDesc(
+		"organization_deletion_requested_seconds",
+		"The time since the deletion of an organization was requested",
+		[]string{"organization"}, nil,
+	)
+	organizationInfoDesc = prometheus.NewDesc(
+		"organization_info",
+		"Information about an organization, always 1, for joining metrics by organization namespace",
+		[]string{"organization", "namespace", "display_name", "support_tier", "parent", "suspended"}, nil,
+	)
+)
+
+// collectedConditions are the conditions organizations are broken down by.
+var collectedConditions = []string{
+	securityv1beta1.ConditionReady,
+	securityv1beta1.ConditionDeleting,
+	securityv1beta1.ConditionSuspended,
+}
+
+// organizationCollector computes the organization metrics from the cached
+// Organizations at scrape time, so that reconciles never have to list them.
+type organizationCollector struct {
+	reader client.Reader
+}
+
+func newOrganizationCollector(reader client.Reader) *organizationCollector {
+	return &organizationCollector{reader: reader}
+}
+
+// Describe implements prometheus.Collector.
+func (c *organizationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- organizationsTotalDesc
+	ch <- organizationsByConditionDesc
+	ch <- organizationDeletionRequestedDesc
+	ch <- organizationInfoDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *organizationCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	var organizationList securityv1beta1.OrganizationList
+	if err := c.reader.List(ctx, &organizationList); err != nil {
+		// Failing the scrape would hide all other metrics too, for example
+		// while the cache is still starting
+		log.Log.Error(err, "Failed to list organizations for metrics")
+		return
+	}
+
+	counts := map[string]map[metav1.ConditionStatus]int{}
+	for _, conditionType := range collectedConditions {
+		counts[conditionType] = map[metav1.ConditionStatus]int{}
+	}
+	now := time.Now()
+	for _, organization := range organizationList.Items {
+		ch <- prometheus.MustNewConstMetric(organizationInfoDesc, prometheus.GaugeValue, 1,
+			organization.Name, organization.Status.Namespace, organization.Spec.DisplayName,
+			string(organization.Spec.SupportTier), organization.Spec.Parent,
+			strconv.FormatBool(organization.Spec.Suspended))
+		if deletionTimestamp := organization.GetDeletionTimestamp(); deletionTimestamp != nil {
+			ch <- prometheus.MustNewConstMetric(organizationDeletionRequestedDesc, prometheus.GaugeValue,
+				now.Sub(deletionTimestamp.Time).Seconds(), organization.Name)
+		}
+		for _, conditionType := range collectedConditions {
+			status := metav1.ConditionUnknown
+			if condition := meta.FindStatusCondition(organization.Status.Conditions, conditionType); condition != nil {
+				status = condition.Status
+			}
+			counts[conditionType][status]++
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(organizationsTotalDesc, prometheus.GaugeValue,
+		float64(len(organizationList.Items)))
+	for _, conditionType := range collectedConditions {
+		for _, status := range []metav1.ConditionStatus{
+			metav1.ConditionTrue, metav1.ConditionFalse, metav1.ConditionUnknown,
+		} {
+			ch <- prometheus.MustNewConstMetric(organizationsByConditionDesc, prometheus.GaugeValue,
+				float64(counts[conditionType][status]), conditionType, string(status))
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/protection"
+)
+
+// resolveNamespace returns the namespace of the organization and, when the
+// organization migrates to the namespace rendered from the current naming
+// template, the namespace it migrates away from. Organizations keep the
+// namespace recorded in their status unless MigrateNamespaceAnnotation is set,
+// and do not migrate while protected resources remain in the old namespace.
+func (r *OrganizationReconciler) resolveNamespace(ctx context.Context, organization *securityv1beta1.Organization) (namespaceName, previous string, result ctrl.Result, err error) { //nolint:lll
+	logger := log.FromContext(ctx)
+
+	namespaceName, err = r.NamespaceTemplate.NamespaceName(organization)
+	if err != nil {
+		r.setNamespaceFailed(organization, err)
+		return "", "", ctrl.Result{}, err
+	}
+
+	current := organization.Status.Namespace
+	if current == "" || current == namespaceName {
+		return namespaceName, "", ctrl.Result{}, nil
+	}
+	if organization.GetAnnotations()[securityv1beta1.MigrateNamespaceAnnotation] != "true" {
+		logger.V(1).Info("Keeping namespace recorded in status", "namespace", current, "rendered", namespaceName)
+		return current, "", ctrl.Result{}, nil
+	}
+
+	checker := &protection.Checker{Client: r.Client, Kinds: r.ProtectedKinds}
+	blocking, err := checker.BlockingResources(ctx, current)
+	if err != nil {
+		r.setNamespaceFailed(organization, err)
+		return "", "", ctrl.Result{}, err
+	}
+	if len(blocking) > 0 {
+		message := fmt.Sprintf("Namespace %s cannot be migrated to %s while it contains %s",
+			current, namespaceName, strings.Join(blocking, ", "))
+		logger.Info("Namespace migration blocked", "namespace", current, "blocking", blocking)
+		setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonMigrationBlocked, message)
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonMigrationBlocked, message)
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonMigrationBlocked,
+			"Reconcile", "%s", message)
+		return "", "", ctrl.Result{RequeueAfter: deletionBlockedRequeueAfter}, nil
+	}
+
+	return namespaceName, current, ctrl.Result{}, nil
+}
+
+// migrateNamespace releases the namespace the organization migrated away from
+// once its new namespace exists, and deletes the objects the operator manages
+// for the organization in it. The old namespace is retained rather than
+// deleted, so that nothing else left in it is lost.
+func (r *OrganizationReconciler) migrateNamespace(ctx context.Context, organization *securityv1beta1.Organization, previous, namespaceName string) error { //nolint:lll
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: previous}, namespace); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get Namespace %s: %w", previous, err)
+	}
+
+	if err := r.pruneMigratedNamespace(ctx, organization, previous); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(namespace.DeepCopy())
+	releaseMetadata(namespace, organization, true)
+	if err := r.Patch(ctx, namespace, patch); err != nil {
+		return fmt.Errorf("failed to release Namespace %s: %w", previous, err)
+	}
+
+	log.FromContext(ctx).Info("Namespace migrated", "from", previous, "to", namespaceName)
+	r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonNamespaceMigrated,
+		"Reconcile", "Migrated from namespace %s to %s, the old namespace is retained without its managed objects",
+		previous, namespaceName)
+	return nil
+}
+
+// pruneMigratedNamespace deletes the RoleBindings, ResourceQuota, LimitRange,
+// NetworkPolicies, ServiceAccounts, token Secrets and replicas the operator
+// manages for the organization in the namespace it migrated away from, so
+// that they no longer grant access or apply limits there.
+func (r *OrganizationReconciler) pruneMigratedNamespace(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	for _, c := range ownedComponents() {
+		if err := r.pruneOwned(ctx, organization, c.list, namespaceName, c.component, nil); err != nil {
+			return err
+		}
+	}
+
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: namespaceName}
+	}
+	if err := r.deleteOwned(ctx, organization, &corev1.ResourceQuota{ObjectMeta: objectMeta(resourceQuotaName)}); err != nil { //nolint:lll
+		return err
+	}
+	return r.deleteOwned(ctx, organization, &corev1.LimitRange{ObjectMeta: objectMeta(limitRangeName)})
+}
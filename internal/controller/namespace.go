@@ -0,0 +1,201 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// checkNamespaceOwnership decides whether an existing namespace may be managed
+// for the organization. It returns whether the namespace has to be adopted, or
+// a message explaining why it must be left alone. A namespace already recorded
+// in the status belongs to the organization even if its labels and
+// ownerReference were removed. Any other namespace, including those labelled
+// for the organization by the operatorkit-based operator or orphaned by a
+// previous Organization, is only adopted with the adoption annotation.
+func checkNamespaceOwnership(namespace *corev1.Namespace, organization *securityv1beta1.Organization) (bool, string) {
+	if metav1.IsControlledBy(namespace, organization) {
+		return false, ""
+	}
+	if owner := metav1.GetControllerOf(namespace); owner != nil {
+		return false, fmt.Sprintf("Namespace %s is controlled by %s %s", namespace.Name, owner.Kind, owner.Name)
+	}
+	if organization.Status.Namespace == namespace.Name {
+		return false, ""
+	}
+	if organization.GetAnnotations()[securityv1beta1.AdoptNamespaceAnnotation] == "true" {
+		return true, ""
+	}
+	return false, fmt.Sprintf("Namespace %s already exists and is not controlled by the organization, "+
+		"set annotation %s=true to adopt it", namespace.Name, securityv1beta1.AdoptNamespaceAnnotation)
+}
+
+// desiredNamespace returns the namespace with the fields the operator reserves
+// for itself: its own labels, the Pod Security Admission labels, the display
+// metadata annotations and, once set, the ownerReference. They are applied
+// server-side by forcing ownership, so changes made by someone else are
+// reverted, while keys set by other controllers are left untouched and keys no
+// longer desired are removed.
+func desiredNamespace(name string, organization *securityv1beta1.Organization) *corev1.Namespace {
+	labels := podSecurityLabels(organization.Spec.Namespace.PodSecurity)
+	labels[securityv1beta1.OrganizationLabel] = organization.Name
+	labels[securityv1beta1.ManagedByLabel] = securityv1beta1.ManagedByValue
+	if organization.Spec.Suspended {
+		labels[securityv1beta1.SuspendedLabel] = "true"
+	}
+
+	annotations := map[string]string{}
+	setDisplayAnnotations(annotations, organization)
+
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}
+}
+
+// specNamespace returns the namespace with the labels and annotations
+// requested in the spec, including the labels inherited from the ancestors of
+// the organization. Keys set by the reserved namespace always win and are
+// left out. They are applied server-side without forcing ownership, so values
+// set by another field manager are reported rather than overwritten.
+func specNamespace(organization *securityv1beta1.Organization, inheritedLabels map[string]string, reserved *corev1.Namespace) *corev1.Namespace { //nolint:lll
+	labels := withoutReservedKeys(organization.Spec.Namespace.Labels)
+	for key, value := range withoutReservedKeys(inheritedLabels) {
+		if _, ok := labels[key]; !ok {
+			labels[key] = value
+		}
+	}
+	annotations := withoutReservedKeys(organization.Spec.Namespace.Annotations)
+	for key := range reserved.Labels {
+		delete(labels, key)
+	}
+	for key := range reserved.Annotations {
+		delete(annotations, key)
+	}
+
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        reserved.Name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}
+}
+
+// conflictingKeys returns the label and annotation keys, as "labels/<key>" and
+// "annotations/<key>", whose ownership conflicts caused the apply conflict.
+// Conflicts on other fields are not returned.
+func conflictingKeys(err error) []string {
+	status, ok := err.(errors.APIStatus)
+	if !ok || status.Status().Details == nil {
+		return nil
+	}
+	var keys []string
+	for _, cause := range status.Status().Details.Causes {
+		for _, field := range []string{"labels", "annotations"} {
+			if key, ok := strings.CutPrefix(cause.Field, ".metadata."+field+"."); ok {
+				keys = append(keys, field+"/"+key)
+			}
+		}
+	}
+	return keys
+}
+
+// setDisplayAnnotations mirrors the display metadata of the organization into
+// the namespace annotations, removing the annotations of unset fields.
+func setDisplayAnnotations(annotations map[string]string, organization *securityv1beta1.Organization) {
+	spec := organization.Spec
+	customerIDs := make([]string, 0, len(spec.CustomerIDs))
+	for _, customerID := range spec.CustomerIDs {
+		customerIDs = append(customerIDs, customerID.System+"="+customerID.ID)
+	}
+
+	setOrDelete(annotations, securityv1beta1.DisplayNameAnnotation, spec.DisplayName)
+	setOrDelete(annotations, securityv1beta1.DescriptionAnnotation, spec.Description)
+	setOrDelete(annotations, securityv1beta1.OwnerEmailsAnnotation, strings.Join(spec.OwnerEmails, ","))
+	setOrDelete(annotations, securityv1beta1.ContactEmailsAnnotation, strings.Join(spec.ContactEmails, ","))
+	setOrDelete(annotations, securityv1beta1.SupportTierAnnotation, string(spec.SupportTier))
+	setOrDelete(annotations, securityv1beta1.CustomerIDsAnnotation, strings.Join(customerIDs, ","))
+}
+
+// podSecurityLabels returns the Pod Security Admission namespace labels of the
+// levels and versions set in spec.namespace.podSecurity.
+func podSecurityLabels(podSecurity *securityv1beta1.PodSecurity) map[string]string {
+	labels := map[string]string{}
+	if podSecurity == nil {
+		return labels
+	}
+	for mode, value := range map[string]string{
+		"enforce":         string(podSecurity.Enforce),
+		"enforce-version": podSecurity.EnforceVersion,
+		"audit":           string(podSecurity.Audit),
+		"audit-version":   podSecurity.AuditVersion,
+		"warn":            string(podSecurity.Warn),
+		"warn-version":    podSecurity.WarnVersion,
+	} {
+		if value != "" {
+			labels[securityv1beta1.PodSecurityLabelPrefix+mode] = value
+		}
+	}
+	return labels
+}
+
+// namespaceDrift returns which of the fields managed by the operator differ
+// between the current and the desired namespace.
+func namespaceDrift(current, desired *corev1.Namespace) []string {
+	var drifted []string
+	if !maps.Equal(current.Labels, desired.Labels) {
+		drifted = append(drifted, "labels")
+	}
+	if !maps.Equal(current.Annotations, desired.Annotations) {
+		drifted = append(drifted, "annotations")
+	}
+	if !equality.Semantic.DeepEqual(current.OwnerReferences, desired.OwnerReferences) {
+		drifted = append(drifted, "ownerReferences")
+	}
+	return drifted
+}
+
+func withoutReservedKeys(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in))
+	for key, value := range in {
+		if !securityv1beta1.IsReservedNamespaceKey(key) {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func setOrDelete(m map[string]string, key, value string) {
+	if value == "" {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
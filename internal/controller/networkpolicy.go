@@ -0,0 +1,157 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+const (
+	networkPolicyComponent = "network-policy"
+
+	defaultDenyPolicyName        = "organization-default-deny"
+	allowSameNamespacePolicyName = "organization-allow-same-namespace"
+	allowManagementPolicyName    = "organization-allow-management"
+	allowDNSPolicyName           = "organization-allow-dns"
+	namespaceNameLabel           = "kubernetes.io/metadata.name"
+	dnsNamespace                 = "kube-system"
+	dnsPodLabel                  = "k8s-app"
+	dnsPodLabelValue             = "kube-dns"
+	dnsPort                      = 53
+)
+
+// DefaultManagementNamespaces are the namespaces of the management cluster
+// components allowed to reach the organization namespace under the Baseline
+// and Strict network isolation profiles.
+var DefaultManagementNamespaces = []string{"giantswarm", "kube-system", "monitoring"}
+
+// reconcileNetworkPolicies renders the network isolation profile of the
+// organization into NetworkPolicies in its namespace and deletes the
+// NetworkPolicies the profile no longer contains.
+func (r *OrganizationReconciler) reconcileNetworkPolicies(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	desired := map[string]bool{}
+	for _, specPolicy := range networkPolicySpecs(organization.Spec.Namespace.NetworkIsolation, r.ManagementNamespaces) {
+		policy := &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      specPolicy.name,
+				Namespace: namespaceName,
+			},
+			Spec: specPolicy.spec,
+		}
+		setManagedLabels(&policy.ObjectMeta, organization)
+		policy.Labels[componentLabel] = networkPolicyComponent
+		desired[policy.Name] = true
+
+		if err := r.applyOwned(ctx, organization, policy); err != nil {
+			return err
+		}
+	}
+
+	return r.pruneOwned(ctx, organization, &networkingv1.NetworkPolicyList{}, namespaceName,
+		networkPolicyComponent, desired)
+}
+
+type namedNetworkPolicySpec struct {
+	name string
+	spec networkingv1.NetworkPolicySpec
+}
+
+// networkPolicySpecs returns the NetworkPolicies of a network isolation
+// profile. Baseline only restricts ingress; Strict restricts egress as well.
+func networkPolicySpecs(isolation securityv1beta1.NetworkIsolation, managementNamespaces []string) []namedNetworkPolicySpec { //nolint:lll
+	var policyTypes []networkingv1.PolicyType
+	switch isolation {
+	case securityv1beta1.NetworkIsolationBaseline:
+		policyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	case securityv1beta1.NetworkIsolationStrict:
+		policyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+	default:
+		return nil
+	}
+	strict := isolation == securityv1beta1.NetworkIsolationStrict
+
+	allowFrom := func(peer networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicySpec {
+		spec := networkingv1.NetworkPolicySpec{
+			PolicyTypes: policyTypes,
+			Ingress:     []networkingv1.NetworkPolicyIngressRule{{From: []networkingv1.NetworkPolicyPeer{peer}}},
+		}
+		if strict {
+			spec.Egress = []networkingv1.NetworkPolicyEgressRule{{To: []networkingv1.NetworkPolicyPeer{peer}}}
+		}
+		return spec
+	}
+
+	specs := []namedNetworkPolicySpec{
+		{
+			name: defaultDenyPolicyName,
+			spec: networkingv1.NetworkPolicySpec{PolicyTypes: policyTypes},
+		},
+		{
+			name: allowSameNamespacePolicyName,
+			spec: allowFrom(networkingv1.NetworkPolicyPeer{PodSelector: &metav1.LabelSelector{}}),
+		},
+	}
+
+	if len(managementNamespaces) > 0 {
+		specs = append(specs, namedNetworkPolicySpec{
+			name: allowManagementPolicyName,
+			spec: allowFrom(networkingv1.NetworkPolicyPeer{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{
+						Key:      namespaceNameLabel,
+						Operator: metav1.LabelSelectorOpIn,
+						Values:   managementNamespaces,
+					}},
+				},
+			}),
+		})
+	}
+
+	if strict {
+		udp, tcp := corev1.ProtocolUDP, corev1.ProtocolTCP
+		port := intstr.FromInt32(dnsPort)
+		specs = append(specs, namedNetworkPolicySpec{
+			name: allowDNSPolicyName,
+			spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress: []networkingv1.NetworkPolicyEgressRule{{
+					To: []networkingv1.NetworkPolicyPeer{{
+						NamespaceSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{namespaceNameLabel: dnsNamespace},
+						},
+						PodSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{dnsPodLabel: dnsPodLabelValue},
+						},
+					}},
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &udp, Port: &port},
+						{Protocol: &tcp, Port: &port},
+					},
+				}},
+			},
+		})
+	}
+
+	return specs
+}
@@ -19,142 +19,403 @@ package controller
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
-	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/events"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	securityv1alpha1 "github.com/giantswarm/organization-operator/api/v1alpha1"
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/naming"
+	"github.com/giantswarm/organization-operator/internal/protection"
 )
 
 const (
 	oldFinalizer = "operatorkit.giantswarm.io/organization-operator-organization-controller"
 	newFinalizer = "organization.giantswarm.io/finalizer"
-)
 
-var (
-	organizationsTotal = prometheus.NewGauge(
-		prometheus.GaugeOpts{
-			Name: "organizations_total",
-			Help: "The total number of existing organizations",
-		},
-	)
+	// deletionBlockedRequeueAfter is how often a blocked deletion is retried.
+	deletionBlockedRequeueAfter = time.Minute
 )
 
-func init() {
-	metrics.Registry.MustRegister(organizationsTotal)
-}
-
 // OrganizationReconciler reconciles a Organization object
 type OrganizationReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme   *runtime.Scheme
+	Recorder events.EventRecorder
+	// ProtectedKinds are the kinds that block the deletion of an organization
+	// while objects of them exist in its namespace.
+	ProtectedKinds []schema.GroupVersionKind
+	// ManagementNamespaces are the namespaces allowed to reach organization
+	// namespaces that select a network isolation profile.
+	ManagementNamespaces []string
+	// NamespaceTemplate renders the namespace name of new organizations. The
+	// nil template renders naming.DefaultTemplate.
+	NamespaceTemplate *naming.Template
+	// ReadOnlyClusterRoles are the ClusterRoles of spec.access that remain
+	// bound while an organization is suspended.
+	ReadOnlyClusterRoles []string
+	// TeardownKinds are the kinds deleted, and waited for, before the
+	// organization namespace is deleted.
+	TeardownKinds []schema.GroupVersionKind
+	// DeletionTimeout is how long the deletion of an organization may take
+	// before it is reported as stuck. Zero disables the check.
+	DeletionTimeout time.Duration
+	// ReplicationNamespace is the namespace whose Secrets and ConfigMaps
+	// annotated for replication are copied into organization namespaces. The
+	// empty namespace disables replication.
+	ReplicationNamespace string
+	// APIReader reads objects the cache does not hold, such as Secrets and
+	// ConfigMaps not managed by the operator. The nil reader reads through
+	// the client.
+	APIReader client.Reader
 }
 
 // Reconcile handles Organization resources by creating corresponding namespaces
 // and managing their lifecycle through the controller runtime.
-func (r *OrganizationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) { //nolint:lll
-	logger := log.FromContext(ctx)
-
+func (r *OrganizationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) { //nolint:lll
 	// Fetch the Organization instance
-	organization := &securityv1alpha1.Organization{}
+	organization := &securityv1beta1.Organization{}
 	if err := r.Get(ctx, req.NamespacedName, organization); err != nil {
+		if errors.IsNotFound(err) {
+			// The Organization is gone, so its deletion can no longer be stuck,
+			// even if it was removed without going through reconcileDelete
+			organizationDeletionStuck.DeleteLabelValues(req.Name)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Always persist the conditions computed below, including on error paths
+	statusPatch := client.MergeFrom(organization.DeepCopy())
+	defer func() {
+		start := time.Now()
+		err := r.patchStatus(ctx, organization, statusPatch)
+		observePhase(phaseStatus, start, ctrl.Result{}, err)
+		if err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+		if reterr != nil {
+			r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonReconcileFailed,
+				"Reconcile", "%s", reterr)
+		}
+	}()
+
 	// Check if the Organization instance is marked to be deleted
 	if organization.GetDeletionTimestamp() != nil {
-		return r.reconcileDelete(ctx, organization)
+		start := time.Now()
+		result, err := r.reconcileDelete(ctx, organization)
+		observePhase(phaseDelete, start, result, err)
+		return result, err
 	}
 
-	// Add finalizer if it doesn't exist
-	if !controllerutil.ContainsFinalizer(organization, newFinalizer) {
-		patch := client.MergeFrom(organization.DeepCopy())
-		controllerutil.AddFinalizer(organization, newFinalizer)
-		if err := r.Patch(ctx, organization, patch); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to add finalizer: %w", err)
-		}
+	// Add finalizer if it doesn't exist, replacing the old one
+	start := time.Now()
+	err := r.reconcileFinalizer(ctx, organization)
+	observePhase(phaseFinalizer, start, ctrl.Result{}, err)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Collect what the organization inherits from its ancestors
+	inherited, result, err := r.resolveInheritance(ctx, organization)
+	if err != nil || !result.IsZero() {
+		return result, err
 	}
 
 	// Create or update the Namespace
-	namespaceName := fmt.Sprintf("org-%s", organization.Name)
+	start = time.Now()
+	namespaceName, result, err := r.reconcileNamespace(ctx, organization, inherited)
+	observePhase(phaseNamespace, start, result, err)
+	if err != nil || !result.IsZero() || namespaceName == "" {
+		return result, err
+	}
+
+	if err := r.reconcileResourceQuota(ctx, organization, namespaceName, inherited.quotaCeiling); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonResourceQuotaFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+	if err := r.reconcileLimitRange(ctx, organization, namespaceName); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonLimitRangeFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileAccess(ctx, organization, namespaceName, inherited.access); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonAccessFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileNetworkPolicies(ctx, organization, namespaceName); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonNetworkPolicyFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileServiceAccounts(ctx, organization, namespaceName); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonServiceAccountsFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileReplication(ctx, organization, namespaceName); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonReplicationFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	if err := r.reconcileClusters(ctx, organization, namespaceName); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonClusterInventoryFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+
+	r.reportSuspension(organization)
+	if namespaceReady := meta.FindStatusCondition(organization.Status.Conditions,
+		securityv1beta1.ConditionNamespaceReady); namespaceReady != nil && namespaceReady.Status != metav1.ConditionTrue {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			namespaceReady.Reason, namespaceReady.Message)
+		return ctrl.Result{}, nil
+	}
+	setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionTrue,
+		securityv1beta1.ReasonReconciled, "Organization is reconciled")
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileFinalizer adds the finalizer to the organization, replacing the
+// finalizer of the operatorkit-based operator.
+func (r *OrganizationReconciler) reconcileFinalizer(ctx context.Context, organization *securityv1beta1.Organization) error { //nolint:lll
+	if controllerutil.ContainsFinalizer(organization, newFinalizer) &&
+		!controllerutil.ContainsFinalizer(organization, oldFinalizer) {
+		return nil
+	}
+
+	patch := client.MergeFrom(organization.DeepCopy())
+	migrated := controllerutil.RemoveFinalizer(organization, oldFinalizer)
+	controllerutil.AddFinalizer(organization, newFinalizer)
+	if err := r.Patch(ctx, organization, patch); err != nil {
+		setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonFinalizerFailed, err.Error())
+		return fmt.Errorf("failed to add finalizer: %w", err)
+	}
+	if migrated {
+		log.FromContext(ctx).Info("Migrated finalizer", "from", oldFinalizer, "to", newFinalizer)
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonFinalizerMigrated,
+			"Reconcile", "Replaced finalizer %s with %s", oldFinalizer, newFinalizer)
+	}
+	return nil
+}
+
+// reconcileNamespace applies the organization namespace, adopting or
+// migrating it as needed, and returns its name. The empty name is returned
+// when the namespace must be left alone, which is reported in the conditions.
+func (r *OrganizationReconciler) reconcileNamespace(ctx context.Context, organization *securityv1beta1.Organization, inherited inheritance) (string, ctrl.Result, error) { //nolint:lll
+	logger := log.FromContext(ctx)
+
+	namespaceName, previousNamespace, result, err := r.resolveNamespace(ctx, organization)
+	if err != nil || !result.IsZero() {
+		return "", result, err
+	}
 	namespace := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: namespaceName,
-			Labels: map[string]string{
-				"giantswarm.io/organization": organization.Name,
-				"giantswarm.io/managed-by":   "organization-operator",
-			},
 		},
 	}
 
-	if err := ctrl.SetControllerReference(organization, namespace, r.Scheme); err != nil {
-		return ctrl.Result{}, fmt.Errorf("unable to set controller reference on Namespace: %w", err)
+	// Any change to a namespace that was in sync with the current generation
+	// has been made by someone else and is drift
+	namespaceReady := meta.FindStatusCondition(organization.Status.Conditions, securityv1beta1.ConditionNamespaceReady)
+	inSync := namespaceReady != nil && namespaceReady.Status == metav1.ConditionTrue &&
+		namespaceReady.ObservedGeneration == organization.Generation
+
+	// Only take over an existing namespace when it may be adopted
+	adopt := false
+	if err := r.Get(ctx, client.ObjectKeyFromObject(namespace), namespace); err == nil {
+		var conflict string
+		adopt, conflict = checkNamespaceOwnership(namespace, organization)
+		if conflict != "" {
+			logger.Info("Namespace conflict", "namespace", namespaceName, "reason", conflict)
+			setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionFalse,
+				securityv1beta1.ReasonNamespaceConflict, conflict)
+			setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+				securityv1beta1.ReasonNamespaceConflict, conflict)
+			r.Recorder.Eventf(organization, namespace, corev1.EventTypeWarning, securityv1beta1.ReasonNamespaceConflict,
+				"Reconcile", "%s", conflict)
+			return "", ctrl.Result{}, nil
+		}
+	} else if !errors.IsNotFound(err) {
+		r.setNamespaceFailed(organization, err)
+		return "", ctrl.Result{}, fmt.Errorf("failed to get Namespace: %w", err)
 	}
 
-	operationResult, err := ctrl.CreateOrUpdate(ctx, r.Client, namespace, func() error {
-		namespace.Labels = map[string]string{
-			"giantswarm.io/organization": organization.Name,
-			"giantswarm.io/managed-by":   "organization-operator",
+	current := namespace.DeepCopy()
+	if current.ResourceVersion != "" {
+		if err := r.upgradeManagedFields(ctx, namespace); err != nil {
+			r.setNamespaceFailed(organization, err)
+			return "", ctrl.Result{}, err
+		}
+	}
+
+	// The reserved metadata and the ownerReference are forced, the labels and
+	// annotations copied from the spec are not. An existing namespace gets
+	// the spec metadata first, so that keys the operator wrote before
+	// splitting them off are never removed in between; a new one is created
+	// with the reserved metadata first, so that it is controlled from the start.
+	namespace = desiredNamespace(namespaceName, organization)
+	if err := ctrl.SetControllerReference(organization, namespace, r.Scheme); err != nil {
+		r.setNamespaceFailed(organization, err)
+		return "", ctrl.Result{}, err
+	}
+	spec := specNamespace(organization, inherited.namespaceLabels, namespace)
+	var conflicts []string
+	applySpec := func() (err error) {
+		conflicts, err = r.applySpecMetadata(ctx, spec)
+		return err
+	}
+	applyReserved := func() error {
+		if err := r.apply(ctx, namespace, client.ForceOwnership); err != nil {
+			return fmt.Errorf("failed to apply Namespace: %w", err)
 		}
 		return nil
-	})
+	}
+	steps := []func() error{applySpec, applyReserved}
+	if current.ResourceVersion == "" {
+		steps = []func() error{applyReserved, applySpec}
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			r.setNamespaceFailed(organization, err)
+			return "", ctrl.Result{}, err
+		}
+	}
 
-	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to create or update Namespace: %w", err)
+	operationResult := controllerutil.OperationResultNone
+	drifted := namespaceDrift(current, namespace)
+	switch {
+	case current.ResourceVersion == "":
+		operationResult = controllerutil.OperationResultCreated
+	case namespace.ResourceVersion != current.ResourceVersion && len(drifted) > 0:
+		operationResult = controllerutil.OperationResultUpdated
 	}
 
 	logger.Info("Namespace reconciled", "result", operationResult)
 
-	// Update Organization status
-	if organization.Status.Namespace != namespaceName {
-		patch := client.MergeFrom(organization.DeepCopy())
-		organization.Status.Namespace = namespaceName
-		if err := r.Status().Patch(ctx, organization, patch); err != nil {
-			return ctrl.Result{}, fmt.Errorf("failed to update Organization status: %w", err)
+	if previousNamespace != "" {
+		if err := r.migrateNamespace(ctx, organization, previousNamespace, namespaceName); err != nil {
+			r.setNamespaceFailed(organization, err)
+			return "", ctrl.Result{}, err
 		}
 	}
 
-	if err := r.updateOrganizationCount(ctx); err != nil {
-		logger.Error(err, "Failed to update organization count")
-		return ctrl.Result{Requeue: true}, err
+	organization.Status.Namespace = namespaceName
+	switch {
+	case adopt:
+		logger.Info("Namespace adopted", "namespace", namespaceName)
+		now := metav1.Now()
+		organization.Status.NamespaceAdoptionTime = &now
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonNamespaceAdopted,
+			"Reconcile", "Adopted existing namespace %s", namespaceName)
+	case operationResult == controllerutil.OperationResultCreated:
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonNamespaceCreated,
+			"Reconcile", "Created namespace %s", namespaceName)
+	case operationResult == controllerutil.OperationResultUpdated && inSync:
+		logger.Info("Namespace drift corrected", "namespace", namespaceName, "fields", drifted)
+		namespaceDriftCorrectedTotal.Inc()
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonDriftCorrected,
+			"Reconcile", "Restored %s of namespace %s", strings.Join(drifted, ", "), namespaceName)
+	case operationResult == controllerutil.OperationResultUpdated:
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonNamespaceUpdated,
+			"Reconcile", "Updated %s of namespace %s", strings.Join(drifted, ", "), namespaceName)
+	}
+	setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+		securityv1beta1.ReasonNotDeleting, "Organization is not being deleted")
+	if len(conflicts) > 0 {
+		// Labels and annotations of the spec have been taken over by another
+		// field manager, leave them to it rather than fight over them
+		logger.Info("Namespace field conflict", "namespace", namespaceName, "fields", conflicts)
+		message := fmt.Sprintf("Namespace %s %s managed by another field manager",
+			namespaceName, strings.Join(conflicts, ", "))
+		setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionFalse,
+			securityv1beta1.ReasonFieldConflict, message)
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeWarning, securityv1beta1.ReasonFieldConflict,
+			"Reconcile", "%s", message)
+		return namespaceName, ctrl.Result{}, nil
 	}
+	setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionTrue,
+		securityv1beta1.ReasonNamespaceReconciled, fmt.Sprintf("Namespace %s is up to date", namespaceName))
 
-	return ctrl.Result{}, nil
+	return namespaceName, ctrl.Result{}, nil
 }
 
-func (r *OrganizationReconciler) reconcileDelete(ctx context.Context, organization *securityv1alpha1.Organization) (ctrl.Result, error) { //nolint:lll
-	log := log.FromContext(ctx)
-
-	// Use the namespace name from the organization status
-	namespaceName := organization.Status.Namespace
-	if namespaceName != "" {
-		// Attempt to delete the namespace without checking for its existence first
-		namespace := &corev1.Namespace{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: namespaceName,
-			},
+// applySpecMetadata applies the labels and annotations of the namespace copied
+// from the spec without forcing ownership. Keys whose value is owned by another
+// field manager are left out and returned, the others are applied.
+func (r *OrganizationReconciler) applySpecMetadata(ctx context.Context, namespace *corev1.Namespace) ([]string, error) { //nolint:lll
+	err := r.apply(ctx, namespace.DeepCopy(), client.FieldOwner(specFieldManager))
+	if !errors.IsConflict(err) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply Namespace metadata: %w", err)
 		}
-		err := r.Delete(ctx, namespace)
-		if err == nil {
-			// If the namespace was found and delete was triggered, requeue
-			log.Info("Namespace deletion triggered, requeuing")
-			return ctrl.Result{Requeue: true}, nil
-		}
-		if !errors.IsNotFound(err) {
-			log.Error(err, "Failed to delete associated namespace")
-			return ctrl.Result{}, err
+		return nil, nil
+	}
+	conflicts := conflictingKeys(err)
+	if len(conflicts) == 0 {
+		return nil, fmt.Errorf("failed to apply Namespace metadata: %w", err)
+	}
+
+	namespace = namespace.DeepCopy()
+	for _, conflict := range conflicts {
+		field, key, _ := strings.Cut(conflict, "/")
+		if field == "labels" {
+			delete(namespace.Labels, key)
+		} else {
+			delete(namespace.Annotations, key)
 		}
-		// If the namespace is not found, we can proceed to remove the finalizer
-		log.Info("Associated namespace not found or already deleted")
+	}
+	if err := r.apply(ctx, namespace, client.FieldOwner(specFieldManager)); err != nil {
+		return nil, fmt.Errorf("failed to apply Namespace metadata: %w", err)
+	}
+	return conflicts, nil
+}
+
+func (r *OrganizationReconciler) reconcileDelete(ctx context.Context, organization *securityv1beta1.Organization) (ctrl.Result, error) { //nolint:lll
+	log := log.FromContext(ctx)
+
+	deleting := meta.FindStatusCondition(organization.Status.Conditions, securityv1beta1.ConditionDeleting)
+	if deleting == nil || deleting.Reason == securityv1beta1.ReasonNotDeleting {
+		log.Info("Organization deletion started")
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonDeletionStarted,
+			"Delete", "Deleting organization with deletion policy %s", organization.GetDeletionPolicy())
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionTrue,
+			securityv1beta1.ReasonDeletionStarted, "Organization deletion started")
+	}
+	setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+		securityv1beta1.ReasonNamespaceDeleting, "Organization is being deleted")
+
+	if result, err := r.teardown(ctx, organization); err != nil || !result.IsZero() {
+		r.checkDeletionTimeout(organization)
+		return result, err
 	}
 
 	// Remove old finalizer if it exists
@@ -163,6 +424,8 @@ func (r *OrganizationReconciler) reconcileDelete(ctx context.Context, organizati
 		controllerutil.RemoveFinalizer(organization, oldFinalizer)
 		if err := r.Update(ctx, organization); err != nil {
 			log.Error(err, "Failed to remove old finalizer")
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonFinalizerRemovalFailed, err.Error())
 			return ctrl.Result{}, err
 		}
 	}
@@ -173,33 +436,316 @@ func (r *OrganizationReconciler) reconcileDelete(ctx context.Context, organizati
 		controllerutil.RemoveFinalizer(organization, newFinalizer)
 		if err := r.Update(ctx, organization); err != nil {
 			log.Error(err, "Failed to remove new finalizer")
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonFinalizerRemovalFailed, err.Error())
 			return ctrl.Result{}, err
 		}
 	}
 
-	if err := r.updateOrganizationCount(ctx); err != nil {
-		log.Error(err, "Failed to update organization count")
-		return ctrl.Result{}, err
-	}
+	organizationDeletionStuck.DeleteLabelValues(organization.Name)
 
 	log.Info("Organization successfully deleted")
+	r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonDeletionCompleted,
+		"Delete", "Organization deleted")
 	return ctrl.Result{}, nil
 }
 
-func (r *OrganizationReconciler) updateOrganizationCount(ctx context.Context) error {
-	var organizationList securityv1alpha1.OrganizationList
-	if err := r.List(ctx, &organizationList); err != nil {
-		return fmt.Errorf("failed to list organizations: %w", err)
+// teardown releases or deletes the organization namespace once the
+// organization has no children. It returns a non-zero result while the
+// teardown is in progress or blocked.
+func (r *OrganizationReconciler) teardown(ctx context.Context, organization *securityv1beta1.Organization) (ctrl.Result, error) { //nolint:lll
+	if result, err := r.checkChildren(ctx, organization); err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	// Use the namespace name from the organization status
+	namespaceName := organization.Status.Namespace
+	if namespaceName == "" {
+		return ctrl.Result{}, nil
+	}
+	if policy := organization.GetDeletionPolicy(); policy != securityv1beta1.DeletionPolicyDelete {
+		return ctrl.Result{}, r.releaseNamespace(ctx, organization, namespaceName, policy)
+	}
+	return r.deleteNamespace(ctx, organization, namespaceName)
+}
+
+// deleteNamespace deletes the organization namespace unless it still contains
+// protected resources. The objects of the teardown kinds are deleted first and
+// the namespace only once they are gone. It returns a non-zero result while
+// the teardown is in progress or blocked.
+func (r *OrganizationReconciler) deleteNamespace(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) (ctrl.Result, error) { //nolint:lll
+	log := log.FromContext(ctx)
+
+	if !protection.IsOverridden(organization) {
+		checker := &protection.Checker{Client: r.Client, Kinds: r.ProtectedKinds}
+		blocking, err := checker.BlockingResources(ctx, namespaceName)
+		if err != nil {
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonNamespaceDeletionFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+		if len(blocking) > 0 {
+			message := fmt.Sprintf("Namespace %s still contains %s, set annotation %s=true to delete anyway",
+				namespaceName, strings.Join(blocking, ", "), securityv1beta1.AllowDeletionAnnotation)
+			log.Info("Organization deletion blocked", "blocking", blocking)
+			setTeardown(organization, securityv1beta1.TeardownStageBlocked, blocking)
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonDeletionBlocked, message)
+			r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonDeletionBlocked,
+				"Delete", "%s", message)
+			return ctrl.Result{RequeueAfter: deletionBlockedRequeueAfter}, nil
+		}
+	}
+
+	// Delete the clusters, Apps and other teardown kinds before the namespace
+	remaining, err := r.deleteTeardownResources(ctx, namespaceName)
+	if err != nil {
+		log.Error(err, "Failed to delete teardown resources")
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+			securityv1beta1.ReasonNamespaceDeletionFailed, err.Error())
+		return ctrl.Result{}, err
+	}
+	if len(remaining) > 0 {
+		message := fmt.Sprintf("Waiting for %s to be deleted", strings.Join(remaining, ", "))
+		log.Info("Waiting for teardown resources to be deleted", "remaining", remaining)
+		if setTeardown(organization, securityv1beta1.TeardownStageDeletingResources, remaining) {
+			r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonDeletingResources,
+				"Delete", "Deleting %s in namespace %s", strings.Join(remaining, ", "), namespaceName)
+		}
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionTrue,
+			securityv1beta1.ReasonDeletingResources, message)
+		return ctrl.Result{RequeueAfter: teardownRequeueAfter}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "Failed to get associated namespace")
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonNamespaceDeletionFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+		// If the namespace is not found, we can proceed to remove the finalizer
+		log.Info("Associated namespace not found or already deleted")
+		return ctrl.Result{}, nil
+	}
+	if namespace.GetDeletionTimestamp() == nil {
+		if err := r.Delete(ctx, namespace); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete associated namespace")
+			setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+				securityv1beta1.ReasonNamespaceDeletionFailed, err.Error())
+			return ctrl.Result{}, err
+		}
+		log.Info("Namespace deletion triggered")
+	}
+	if setTeardown(organization, securityv1beta1.TeardownStageDeletingNamespace, []string{"Namespace/" + namespaceName}) {
+		r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, securityv1beta1.ReasonNamespaceDeleting,
+			"Delete", "Deleting namespace %s", namespaceName)
+	}
+	setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionTrue,
+		securityv1beta1.ReasonNamespaceDeleting, fmt.Sprintf("Waiting for namespace %s to be deleted", namespaceName))
+	setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionFalse,
+		securityv1beta1.ReasonNamespaceDeleting, fmt.Sprintf("Namespace %s is being deleted", namespaceName))
+	return ctrl.Result{RequeueAfter: teardownRequeueAfter}, nil
+}
+
+// releaseNamespace keeps the organization namespace and the objects the
+// operator manages in it but removes the organization's ownerReference, so
+// that they are not garbage collected together with the organization.
+// Retained namespaces and objects also lose the managed-by label and the
+// operator's annotations; orphaned ones keep them.
+func (r *OrganizationReconciler) releaseNamespace(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string, policy securityv1beta1.DeletionPolicy) error { //nolint:lll
+	log := log.FromContext(ctx)
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Associated namespace not found or already deleted")
+			return nil
+		}
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+			securityv1beta1.ReasonNamespaceReleaseFailed, err.Error())
+		return err
+	}
+
+	retain := policy == securityv1beta1.DeletionPolicyRetain
+	if err := r.releaseOwned(ctx, organization, namespaceName, retain); err != nil {
+		log.Error(err, "Failed to release objects in associated namespace")
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+			securityv1beta1.ReasonNamespaceReleaseFailed, err.Error())
+		return err
+	}
+
+	patch := client.MergeFrom(namespace.DeepCopy())
+	releaseMetadata(namespace, organization, retain)
+	reason := securityv1beta1.ReasonNamespaceOrphaned
+	if retain {
+		reason = securityv1beta1.ReasonNamespaceRetained
+	}
+	if err := r.Patch(ctx, namespace, patch); err != nil {
+		log.Error(err, "Failed to release associated namespace")
+		setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionFalse,
+			securityv1beta1.ReasonNamespaceReleaseFailed, err.Error())
+		return err
+	}
+
+	message := fmt.Sprintf("Namespace %s is kept according to deletion policy %s", namespaceName, policy)
+	log.Info("Associated namespace released", "policy", policy)
+	setCondition(organization, securityv1beta1.ConditionDeleting, metav1.ConditionTrue, reason, message)
+	r.Recorder.Eventf(organization, namespace, corev1.EventTypeNormal, reason, "Delete", "%s", message)
+	return nil
+}
+
+// reportSuspension sets the Suspended condition and records an event when the
+// organization is suspended or resumed. It is called once the suspension has
+// been applied to the namespace and its objects.
+func (r *OrganizationReconciler) reportSuspension(organization *securityv1beta1.Organization) {
+	wasSuspended := meta.IsStatusConditionTrue(organization.Status.Conditions, securityv1beta1.ConditionSuspended)
+	if !organization.Spec.Suspended {
+		setCondition(organization, securityv1beta1.ConditionSuspended, metav1.ConditionFalse,
+			securityv1beta1.ReasonNotSuspended, "Organization is active")
+		if wasSuspended {
+			r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonNotSuspended,
+				"Resume", "Organization resumed, quota and access restored")
+		}
+		return
+	}
+
+	setCondition(organization, securityv1beta1.ConditionSuspended, metav1.ConditionTrue,
+		securityv1beta1.ReasonSuspended, "Organization is suspended, quota is zero and only read-only access is bound")
+	if !wasSuspended {
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeNormal, securityv1beta1.ReasonSuspended,
+			"Suspend", "Organization suspended")
+	}
+}
+
+// setNamespaceFailed marks the namespace and the organization as not ready.
+func (r *OrganizationReconciler) setNamespaceFailed(organization *securityv1beta1.Organization, err error) {
+	setCondition(organization, securityv1beta1.ConditionNamespaceReady, metav1.ConditionFalse,
+		securityv1beta1.ReasonNamespaceFailed, err.Error())
+	setCondition(organization, securityv1beta1.ConditionReady, metav1.ConditionFalse,
+		securityv1beta1.ReasonNamespaceFailed, err.Error())
+}
+
+// patchStatus writes the observed generation and conditions back to the API
+// server. An organization that is already gone has no status left to patch.
+func (r *OrganizationReconciler) patchStatus(ctx context.Context, organization *securityv1beta1.Organization, patch client.Patch) error { //nolint:lll
+	organization.Status.ObservedGeneration = organization.Generation
+	if err := r.Status().Patch(ctx, organization, patch); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to update Organization status: %w", err)
 	}
-	organizationsTotal.Set(float64(len(organizationList.Items)))
 	return nil
 }
 
+// setCondition sets a condition on the organization, stamped with its
+// current generation.
+func setCondition(organization *securityv1beta1.Organization, conditionType string, status metav1.ConditionStatus, reason, message string) { //nolint:lll
+	meta.SetStatusCondition(&organization.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: organization.Generation,
+	})
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *OrganizationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&securityv1alpha1.Organization{}).
-		Owns(&corev1.Namespace{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
-		Complete(r)
+	if err := metrics.Registry.Register(newOrganizationCollector(mgr.GetCache())); err != nil {
+		return fmt.Errorf("failed to register organization metrics: %w", err)
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
+		For(&securityv1beta1.Organization{},
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{})),
+		).
+		Owns(&corev1.Namespace{}, builder.WithPredicates(managedMetadataChangedPredicate())).
+		Owns(&corev1.ResourceQuota{}, builder.WithPredicates(resourceQuotaChangedPredicate())).
+		Owns(&corev1.LimitRange{}).
+		Owns(&rbacv1.RoleBinding{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
+		Owns(&corev1.ServiceAccount{}).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.replicationRequests),
+			builder.WithPredicates(r.replicationSourcePredicate())).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.replicationRequests),
+			builder.WithPredicates(r.replicationSourcePredicate())).
+		Watches(&securityv1beta1.Organization{}, handler.EnqueueRequestsFromMapFunc(r.descendantRequests),
+			builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, namespaceStatusChangedPredicate())))
+
+	// Clusters are only watched when Cluster API is installed.
+	_, err := mgr.GetRESTMapper().RESTMapping(protection.ClusterKind.GroupKind(), protection.ClusterKind.Version)
+	switch {
+	case err == nil:
+		cluster := &unstructured.Unstructured{}
+		cluster.SetGroupVersionKind(protection.ClusterKind)
+		controllerBuilder = controllerBuilder.Watches(cluster, handler.EnqueueRequestsFromMapFunc(r.clusterRequests),
+			builder.WithPredicates(clusterChangedPredicate()))
+	case !meta.IsNoMatchError(err):
+		return fmt.Errorf("failed to look up %s: %w", protection.ClusterKind, err)
+	}
+
+	return controllerBuilder.Complete(r)
+}
+
+// namespaceStatusChangedPredicate passes Organization updates that change the
+// namespace recorded in the status, which descendants inherit service account
+// namespaces from.
+func namespaceStatusChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldOrganization, ok := e.ObjectOld.(*securityv1beta1.Organization)
+			if !ok {
+				return false
+			}
+			newOrganization, ok := e.ObjectNew.(*securityv1beta1.Organization)
+			if !ok {
+				return false
+			}
+			return oldOrganization.Status.Namespace != newOrganization.Status.Namespace
+		},
+	}
+}
+
+// resourceQuotaChangedPredicate passes ResourceQuota updates that change its
+// spec or the metadata managed by the operator. Core objects do not track
+// their generation, and the usage in the status changes with every pod
+// created, so it is refreshed whenever the organization is reconciled rather
+// than on every change.
+func resourceQuotaChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		managedMetadataChangedPredicate(),
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				oldQuota, ok := e.ObjectOld.(*corev1.ResourceQuota)
+				if !ok {
+					return false
+				}
+				newQuota, ok := e.ObjectNew.(*corev1.ResourceQuota)
+				if !ok {
+					return false
+				}
+				return !equality.Semantic.DeepEqual(oldQuota.Spec, newQuota.Spec)
+			},
+		},
+	)
+}
+
+// managedMetadataChangedPredicate passes updates of namespaces and other core
+// objects that touch the metadata managed by the operator, which never changes
+// the generation.
+func managedMetadataChangedPredicate() predicate.Predicate {
+	return predicate.Or(
+		predicate.LabelChangedPredicate{},
+		predicate.AnnotationChangedPredicate{},
+		predicate.Funcs{
+			UpdateFunc: func(e event.UpdateEvent) bool {
+				if e.ObjectOld == nil || e.ObjectNew == nil {
+					return false
+				}
+				return !equality.Semantic.DeepEqual(e.ObjectOld.GetOwnerReferences(), e.ObjectNew.GetOwnerReferences())
+			},
+		},
+	)
 }
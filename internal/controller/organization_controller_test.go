@@ -35,19 +35,33 @@ package controller
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	securityv1alpha1 "github.com/giantswarm/organization-operator/api/v1alpha1"
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/naming"
+	"github.com/giantswarm/organization-operator/internal/protection"
 )
 
 var _ = ginkgo.Describe("Organization controller", func() {
@@ -56,19 +70,75 @@ var _ = ginkgo.Describe("Organization controller", func() {
 		interval = time.Millisecond * 250
 	)
 
+	// organizationMetric scrapes the organization collector and returns the
+	// value of the metric with the given name and label values.
+	organizationMetric := func(name string, labelValues ...string) float64 {
+		registry := prometheus.NewPedanticRegistry()
+		registry.MustRegister(newOrganizationCollector(k8sClient))
+		families, err := registry.Gather()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		for _, family := range families {
+			if family.GetName() != name {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				values := []string{}
+				for _, label := range metric.GetLabel() {
+					values = append(values, label.GetValue())
+				}
+				if slices.Equal(values, labelValues) {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+		ginkgo.Fail(fmt.Sprintf("metric %s%v not found", name, labelValues))
+		return 0
+	}
+
+	// newReconciler returns a reconciler on the fake client that discards its
+	// events. opts set the recorder, the flags or another client.
+	newReconciler := func(opts ...func(*OrganizationReconciler)) *OrganizationReconciler {
+		reconciler := &OrganizationReconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			Recorder: &events.FakeRecorder{},
+		}
+		for _, opt := range opts {
+			opt(reconciler)
+		}
+		return reconciler
+	}
+
+	// withRecorder makes the reconciler record its events with recorder.
+	withRecorder := func(recorder events.EventRecorder) func(*OrganizationReconciler) {
+		return func(r *OrganizationReconciler) {
+			r.Recorder = recorder
+		}
+	}
+
+	// reconcileOrganization reconciles the named organization and expects it
+	// to succeed.
+	reconcileOrganization := func(ctx context.Context, reconciler *OrganizationReconciler, name string) ctrl.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		return result
+	}
+
 	// Helper function for testing finalizer removal
 	testFinalizerRemoval := func(ctx context.Context,
 		name string,
 		finalizer string,
 		namespaceName string,
 		errorMessage string) {
-		org := &securityv1alpha1.Organization{
+		org := &securityv1beta1.Organization{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:       name,
 				Finalizers: []string{finalizer},
 			},
-			Spec: securityv1alpha1.OrganizationSpec{},
-			Status: securityv1alpha1.OrganizationStatus{
+			Spec: securityv1beta1.OrganizationSpec{},
+			Status: securityv1beta1.OrganizationStatus{
 				Namespace: namespaceName,
 			},
 		}
@@ -82,17 +152,14 @@ var _ = ginkgo.Describe("Organization controller", func() {
 		}
 		gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
 
-		reconciler := &OrganizationReconciler{
-			Client: k8sClient,
-			Scheme: k8sClient.Scheme(),
-		}
+		reconciler := newReconciler()
 
 		// Trigger deletion
 		gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
 
 		// Wait for the organization to be fully deleted
 		gomega.Eventually(func() error {
-			err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, &securityv1alpha1.Organization{})
+			err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, &securityv1beta1.Organization{})
 			if errors.IsNotFound(err) {
 				return nil
 			}
@@ -100,10 +167,7 @@ var _ = ginkgo.Describe("Organization controller", func() {
 				return err
 			}
 			// Trigger reconciliation if the organization still exists
-			_, err = reconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: types.NamespacedName{Name: name},
-			})
-			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			reconcileOrganization(ctx, reconciler, name)
 			return fmt.Errorf("%s", errorMessage)
 		}, timeout, interval).Should(gomega.Succeed())
 
@@ -117,9 +181,9 @@ var _ = ginkgo.Describe("Organization controller", func() {
 		}, timeout, interval).Should(gomega.Succeed())
 
 		// Verify that the organization count metric has been updated
-		initialCount := testutil.ToFloat64(organizationsTotal)
+		initialCount := organizationMetric("organizations_total")
 		gomega.Consistently(func() bool {
-			currentCount := testutil.ToFloat64(organizationsTotal)
+			currentCount := organizationMetric("organizations_total")
 			return currentCount <= initialCount
 		}, timeout, interval).Should(gomega.BeTrue())
 	}
@@ -130,23 +194,17 @@ var _ = ginkgo.Describe("Organization controller", func() {
 			ctx := context.Background()
 
 			ginkgo.By("Creating the first organization")
-			org1 := &securityv1alpha1.Organization{
+			org1 := &securityv1beta1.Organization{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-1",
 				},
-				Spec: securityv1alpha1.OrganizationSpec{},
+				Spec: securityv1beta1.OrganizationSpec{},
 			}
 			gomega.Expect(k8sClient.Create(ctx, org1)).To(gomega.Succeed())
 
-			reconciler := &OrganizationReconciler{
-				Client: k8sClient,
-				Scheme: k8sClient.Scheme(),
-			}
+			reconciler := newReconciler()
 
-			_, err := reconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: types.NamespacedName{Name: "test-1"},
-			})
-			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			reconcileOrganization(ctx, reconciler, "test-1")
 
 			ginkgo.By("Checking if the Namespace was created")
 			namespaceName := "org-test-1"
@@ -162,7 +220,7 @@ var _ = ginkgo.Describe("Organization controller", func() {
 			)
 
 			ginkgo.By("Verifying the Organization status was updated")
-			updatedOrg := &securityv1alpha1.Organization{}
+			updatedOrg := &securityv1beta1.Organization{}
 			gomega.Eventually(func() string {
 				err := k8sClient.Get(ctx, client.ObjectKey{Name: "test-1"}, updatedOrg)
 				if err != nil {
@@ -170,53 +228,60 @@ var _ = ginkgo.Describe("Organization controller", func() {
 				}
 				return updatedOrg.Status.Namespace
 			}, timeout, interval).Should(gomega.Equal(namespaceName))
+			gomega.Expect(meta.IsStatusConditionTrue(updatedOrg.Status.Conditions,
+				securityv1beta1.ConditionReady)).To(gomega.BeTrue())
+			gomega.Expect(meta.IsStatusConditionTrue(updatedOrg.Status.Conditions,
+				securityv1beta1.ConditionNamespaceReady)).To(gomega.BeTrue())
+			gomega.Expect(meta.IsStatusConditionFalse(updatedOrg.Status.Conditions,
+				securityv1beta1.ConditionDeleting)).To(gomega.BeTrue())
+			gomega.Expect(updatedOrg.Status.ObservedGeneration).To(gomega.Equal(updatedOrg.Generation))
 
 			ginkgo.By("Verifying the total organizations metric is 1")
 			gomega.Eventually(func() float64 {
-				return testutil.ToFloat64(organizationsTotal)
+				return organizationMetric("organizations_total")
 			}, timeout, interval).Should(gomega.Equal(float64(1)))
+			gomega.Expect(organizationMetric("organizations_by_condition", "Ready", "True")).To(gomega.Equal(float64(1)))
+			gomega.Expect(organizationMetric("organizations_by_condition", "Deleting", "False")).To(gomega.Equal(float64(1)))
+			gomega.Expect(organizationMetric("organizations_by_condition", "Suspended", "False")).To(gomega.Equal(float64(1)))
+			gomega.Expect(organizationMetric("organization_info", "", namespaceName, "test-1", "", "", "false")).To(
+				gomega.Equal(float64(1)))
 
 			ginkgo.By("Creating a second organization")
-			org2 := &securityv1alpha1.Organization{
+			org2 := &securityv1beta1.Organization{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: "test-2",
 				},
-				Spec: securityv1alpha1.OrganizationSpec{},
+				Spec: securityv1beta1.OrganizationSpec{},
 			}
 			gomega.Expect(k8sClient.Create(ctx, org2)).To(gomega.Succeed())
 
-			_, err = reconciler.Reconcile(ctx, reconcile.Request{
-				NamespacedName: types.NamespacedName{Name: "test-2"},
-			})
-			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			reconcileOrganization(ctx, reconciler, "test-2")
 
 			ginkgo.By("Verifying the total organizations metric is 2")
 			gomega.Eventually(func() float64 {
-				return testutil.ToFloat64(organizationsTotal)
+				return organizationMetric("organizations_total")
 			}, timeout, interval).Should(gomega.Equal(float64(2)))
 
 			ginkgo.By("Deleting the first organization")
 			gomega.Expect(k8sClient.Delete(ctx, org1)).To(gomega.Succeed())
 
 			gomega.Eventually(func() error {
-				err := k8sClient.Get(ctx, client.ObjectKey{Name: "test-1"}, &securityv1alpha1.Organization{})
+				err := k8sClient.Get(ctx, client.ObjectKey{Name: "test-1"}, &securityv1beta1.Organization{})
 				if errors.IsNotFound(err) {
 					return nil
 				}
 				if err != nil {
 					return err
 				}
-				_, err = reconciler.Reconcile(ctx, reconcile.Request{
-					NamespacedName: types.NamespacedName{Name: "test-1"},
-				})
-				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				reconcileOrganization(ctx, reconciler, "test-1")
 				return fmt.Errorf("organization still exists")
 			}, timeout, interval).Should(gomega.Succeed())
 
 			ginkgo.By("Verifying the total organizations metric is back to 1")
 			gomega.Eventually(func() float64 {
-				return testutil.ToFloat64(organizationsTotal)
+				return organizationMetric("organizations_total")
 			}, timeout, interval).Should(gomega.Equal(float64(1)))
+			gomega.Expect(testutil.CollectAndCount(newOrganizationCollector(k8sClient), "organization_info")).To(gomega.Equal(1))
 		})
 
 		ginkgo.It("Should remove the finalizer when deleting an Organization", func() {
@@ -231,6 +296,1765 @@ var _ = ginkgo.Describe("Organization controller", func() {
 		})
 	})
 
+	ginkgo.Context("When an Organization requests Namespace labels and annotations", func() {
+		ginkgo.It("Should merge them into the Namespace without clobbering foreign keys", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-labels",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels: map[string]string{
+							"cost-center":                "1234",
+							"team":                       "platform",
+							"giantswarm.io/organization": "someone-else",
+						},
+						Annotations: map[string]string{
+							"example.com/owner": "platform@example.com",
+						},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-labels"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("cost-center", "1234"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("team", "platform"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("giantswarm.io/organization", "test-labels"))
+			gomega.Expect(namespace.Annotations).To(gomega.HaveKeyWithValue("example.com/owner", "platform@example.com"))
+
+			ginkgo.By("Adding a label from another controller")
+			namespace.Labels["kubernetes.io/other"] = "value"
+			gomega.Expect(k8sClient.Update(ctx, namespace)).To(gomega.Succeed())
+
+			ginkgo.By("Removing a label from the spec")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			delete(org.Spec.Namespace.Labels, "team")
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-labels"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).NotTo(gomega.HaveKey("team"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("cost-center", "1234"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("kubernetes.io/other", "value"))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+		ginkgo.It("Should report spec fields taken over by another field manager instead of overwriting them", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-field-conflict",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels: map[string]string{"team": "platform", "cost-center": "1234"},
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							},
+						},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			ginkgo.By("Changing a spec label and a reserved label with another field manager")
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-field-conflict"}, namespace)).To(gomega.Succeed())
+			namespace.Labels["team"] = "security"
+			namespace.Labels[securityv1beta1.OrganizationLabel] = "someone-else"
+			gomega.Expect(k8sClient.Update(ctx, namespace, client.FieldOwner("someone-else"))).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-field-conflict"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("team", "security"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("cost-center", "1234"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(securityv1beta1.OrganizationLabel, org.Name))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			namespaceReady := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionNamespaceReady)
+			gomega.Expect(namespaceReady).NotTo(gomega.BeNil())
+			gomega.Expect(namespaceReady.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(namespaceReady.Reason).To(gomega.Equal(securityv1beta1.ReasonFieldConflict))
+			gomega.Expect(namespaceReady.Message).To(gomega.ContainSubstring("labels/team"))
+			gomega.Expect(namespaceReady.Message).NotTo(gomega.ContainSubstring(securityv1beta1.OrganizationLabel))
+			ready := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionReady)
+			gomega.Expect(ready.Reason).To(gomega.Equal(securityv1beta1.ReasonFieldConflict))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDriftCorrected)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+				gomega.HavePrefix(corev1.EventTypeWarning),
+				gomega.ContainSubstring(securityv1beta1.ReasonFieldConflict),
+			)))
+
+			ginkgo.By("Reconciling the objects in the Namespace regardless")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-field-conflict", Name: resourceQuotaName},
+				&corev1.ResourceQuota{})).To(gomega.Succeed())
+
+			ginkgo.By("Agreeing on the value in the spec")
+			org.Spec.Namespace.Labels["team"] = "security"
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(meta.IsStatusConditionTrue(org.Status.Conditions,
+				securityv1beta1.ConditionNamespaceReady)).To(gomega.BeTrue())
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When the Namespace already exists", func() {
+		ginkgo.It("Should report a conflict and adopt the Namespace only when asked to", func() {
+			ctx := context.Background()
+
+			foreign := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "org-test-adopt",
+					Labels: map[string]string{"created-by": "hand"},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, foreign)).To(gomega.Succeed())
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-adopt",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			ginkgo.By("Leaving the foreign Namespace untouched")
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-adopt"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).NotTo(gomega.HaveKey(securityv1beta1.ManagedByLabel))
+			gomega.Expect(namespace.OwnerReferences).To(gomega.BeEmpty())
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.BeEmpty())
+			namespaceReady := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionNamespaceReady)
+			gomega.Expect(namespaceReady).NotTo(gomega.BeNil())
+			gomega.Expect(namespaceReady.Reason).To(gomega.Equal(securityv1beta1.ReasonNamespaceConflict))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceConflict)))
+
+			ginkgo.By("Adopting the Namespace once the Organization carries the adoption annotation")
+			org.Annotations = map[string]string{securityv1beta1.AdoptNamespaceAnnotation: "true"}
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-adopt"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(metav1.IsControlledBy(namespace, org)).To(gomega.BeTrue())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("created-by", "hand"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(securityv1beta1.OrganizationLabel, org.Name))
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.Equal("org-test-adopt"))
+			gomega.Expect(org.Status.NamespaceAdoptionTime).NotTo(gomega.BeNil())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceAdopted)))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should not adopt a Namespace labelled for the Organization without the annotation", func() {
+			ctx := context.Background()
+
+			legacy := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "org-test-legacy",
+					Labels: map[string]string{
+						securityv1beta1.OrganizationLabel: "test-legacy",
+						securityv1beta1.ManagedByLabel:    securityv1beta1.ManagedByValue,
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, legacy)).To(gomega.Succeed())
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-legacy",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-legacy"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.OwnerReferences).To(gomega.BeEmpty())
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.BeEmpty())
+			namespaceReady := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionNamespaceReady)
+			gomega.Expect(namespaceReady).NotTo(gomega.BeNil())
+			gomega.Expect(namespaceReady.Reason).To(gomega.Equal(securityv1beta1.ReasonNamespaceConflict))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When the managed Namespace drifts", func() {
+		ginkgo.It("Should repair it and report the correction", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-drift",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+			gomega.Expect(recorder.Events).NotTo(gomega.Receive())
+			driftBefore := testutil.ToFloat64(namespaceDriftCorrectedTotal)
+
+			ginkgo.By("Stripping the organization label and the ownerReference")
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-drift"}, namespace)).To(gomega.Succeed())
+			oldNamespace := namespace.DeepCopy()
+			delete(namespace.Labels, securityv1beta1.OrganizationLabel)
+			namespace.OwnerReferences = nil
+			gomega.Expect(k8sClient.Update(ctx, namespace)).To(gomega.Succeed())
+			gomega.Expect(managedMetadataChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: oldNamespace,
+				ObjectNew: namespace,
+			})).To(gomega.BeTrue())
+
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-drift"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(securityv1beta1.OrganizationLabel, org.Name))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(metav1.IsControlledBy(namespace, org)).To(gomega.BeTrue())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDriftCorrected)))
+			gomega.Expect(testutil.ToFloat64(namespaceDriftCorrectedTotal)).To(gomega.Equal(driftBefore + 1))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should ignore Namespace updates that leave the managed metadata alone", func() {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "org-test-unrelated",
+					Labels: map[string]string{securityv1beta1.OrganizationLabel: "test-unrelated"},
+				},
+			}
+			updated := namespace.DeepCopy()
+			updated.Status.Phase = corev1.NamespaceTerminating
+			gomega.Expect(managedMetadataChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: namespace,
+				ObjectNew: updated,
+			})).To(gomega.BeFalse())
+		})
+	})
+
+	ginkgo.Context("When an Organization declares a ResourceQuota and a LimitRange", func() {
+		ginkgo.It("Should keep them in sync with the spec and report the quota usage", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-quota",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							},
+						},
+						LimitRange: &corev1.LimitRangeSpec{
+							Limits: []corev1.LimitRangeItem{{
+								Type: corev1.LimitTypeContainer,
+								Default: corev1.ResourceList{
+									corev1.ResourceCPU: resource.MustParse("500m"),
+								},
+							}},
+						},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			quota := &corev1.ResourceQuota{}
+			quotaKey := client.ObjectKey{Namespace: "org-test-quota", Name: resourceQuotaName}
+			gomega.Expect(k8sClient.Get(ctx, quotaKey, quota)).To(gomega.Succeed())
+			gomega.Expect(quota.Spec.Hard).To(gomega.HaveKeyWithValue(corev1.ResourceRequestsCPU, resource.MustParse("10")))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(metav1.IsControlledBy(quota, org)).To(gomega.BeTrue())
+
+			limitRange := &corev1.LimitRange{}
+			limitRangeKey := client.ObjectKey{Namespace: "org-test-quota", Name: limitRangeName}
+			gomega.Expect(k8sClient.Get(ctx, limitRangeKey, limitRange)).To(gomega.Succeed())
+			gomega.Expect(limitRange.Spec.Limits).To(gomega.HaveLen(1))
+
+			ginkgo.By("Reporting the usage computed by the quota controller")
+			quota.Status = corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("10")},
+				Used: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+			}
+			gomega.Expect(k8sClient.Update(ctx, quota)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.ResourceQuota).NotTo(gomega.BeNil())
+			used := org.Status.ResourceQuota.Used[corev1.ResourceRequestsCPU]
+			gomega.Expect(used.String()).To(gomega.Equal("2"))
+
+			ginkgo.By("Updating the quota and removing the LimitRange from the spec")
+			org.Spec.Namespace.ResourceQuota.Hard[corev1.ResourceRequestsCPU] = resource.MustParse("20")
+			org.Spec.Namespace.LimitRange = nil
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, quotaKey, quota)).To(gomega.Succeed())
+			gomega.Expect(quota.Spec.Hard).To(gomega.HaveKeyWithValue(corev1.ResourceRequestsCPU, resource.MustParse("20")))
+			err := k8sClient.Get(ctx, limitRangeKey, &corev1.LimitRange{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should ignore ResourceQuota updates that only change the usage", func() {
+			quota := &corev1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      resourceQuotaName,
+					Namespace: "org-test-quota-usage",
+				},
+				Spec: corev1.ResourceQuotaSpec{
+					Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+				},
+			}
+			used := quota.DeepCopy()
+			used.Status.Used = corev1.ResourceList{corev1.ResourcePods: resource.MustParse("1")}
+			gomega.Expect(resourceQuotaChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: quota,
+				ObjectNew: used,
+			})).To(gomega.BeFalse())
+
+			edited := quota.DeepCopy()
+			edited.Spec.Hard[corev1.ResourcePods] = resource.MustParse("100")
+			gomega.Expect(resourceQuotaChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: quota,
+				ObjectNew: edited,
+			})).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.Context("When an Organization declares access bindings", func() {
+		ginkgo.It("Should create a RoleBinding per ClusterRole and prune removed ones", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-access",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Access: []securityv1beta1.AccessBinding{
+						{
+							ClusterRole: "admin",
+							Groups:      []string{"customer:admins"},
+							ServiceAccounts: []securityv1beta1.ServiceAccountReference{
+								{Name: "automation"},
+							},
+						},
+						{
+							ClusterRole: "view",
+							Users:       []string{"jane@example.com"},
+						},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			adminKey := client.ObjectKey{Namespace: "org-test-access", Name: "organization-access-admin"}
+			viewKey := client.ObjectKey{Namespace: "org-test-access", Name: "organization-access-view"}
+
+			admin := &rbacv1.RoleBinding{}
+			gomega.Expect(k8sClient.Get(ctx, adminKey, admin)).To(gomega.Succeed())
+			gomega.Expect(admin.RoleRef.Kind).To(gomega.Equal("ClusterRole"))
+			gomega.Expect(admin.RoleRef.Name).To(gomega.Equal("admin"))
+			gomega.Expect(admin.Subjects).To(gomega.ConsistOf(
+				rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "customer:admins"},
+				rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "automation", Namespace: "org-test-access"},
+			))
+
+			view := &rbacv1.RoleBinding{}
+			gomega.Expect(k8sClient.Get(ctx, viewKey, view)).To(gomega.Succeed())
+			gomega.Expect(view.Subjects).To(gomega.ConsistOf(
+				rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "jane@example.com"},
+			))
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(metav1.IsControlledBy(admin, org)).To(gomega.BeTrue())
+			gomega.Expect(org.Status.AccessRoleBindings).To(gomega.Equal([]string{
+				"organization-access-admin", "organization-access-view",
+			}))
+
+			ginkgo.By("Leaving RoleBindings not managed by the operator alone")
+			foreign := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foreign",
+					Namespace: "org-test-access",
+				},
+				RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "edit"},
+			}
+			gomega.Expect(k8sClient.Create(ctx, foreign)).To(gomega.Succeed())
+
+			ginkgo.By("Removing an access binding from the spec")
+			org.Spec.Access = org.Spec.Access[:1]
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			err := k8sClient.Get(ctx, viewKey, &rbacv1.RoleBinding{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Get(ctx, adminKey, &rbacv1.RoleBinding{})).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(foreign), &rbacv1.RoleBinding{})).To(gomega.Succeed())
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.AccessRoleBindings).To(gomega.Equal([]string{"organization-access-admin"}))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should take over RoleBindings written with client-side updates", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-access-upgrade",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+
+			legacy := &rbacv1.RoleBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "organization-access-view",
+					Namespace: "org-test-access-upgrade",
+				},
+				RoleRef: rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "view"},
+				Subjects: []rbacv1.Subject{
+					{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "customer:former"},
+				},
+			}
+			setManagedLabels(&legacy.ObjectMeta, org)
+			legacy.Labels[componentLabel] = accessComponent
+			gomega.Expect(ctrl.SetControllerReference(org, legacy, k8sClient.Scheme())).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Create(ctx, legacy, client.FieldOwner(legacyFieldManager))).To(gomega.Succeed())
+
+			org.Spec.Access = []securityv1beta1.AccessBinding{{ClusterRole: "view", Groups: []string{"customer:viewers"}}}
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			view := &rbacv1.RoleBinding{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(legacy), view)).To(gomega.Succeed())
+			gomega.Expect(view.Subjects).To(gomega.ConsistOf(
+				rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "customer:viewers"},
+			))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When an Organization selects a network isolation profile", func() {
+		ginkgo.It("Should render it into NetworkPolicies and keep them in sync", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-network",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						NetworkIsolation: securityv1beta1.NetworkIsolationStrict,
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler(func(r *OrganizationReconciler) {
+				r.ManagementNamespaces = DefaultManagementNamespaces
+			})
+			policyNames := func() []string {
+				policies := &networkingv1.NetworkPolicyList{}
+				gomega.Expect(k8sClient.List(ctx, policies, client.InNamespace("org-test-network"))).To(gomega.Succeed())
+				names := []string{}
+				for _, policy := range policies.Items {
+					names = append(names, policy.Name)
+				}
+				return names
+			}
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(policyNames()).To(gomega.ConsistOf(defaultDenyPolicyName, allowSameNamespacePolicyName,
+				allowManagementPolicyName, allowDNSPolicyName))
+
+			denyKey := client.ObjectKey{Namespace: "org-test-network", Name: defaultDenyPolicyName}
+			deny := &networkingv1.NetworkPolicy{}
+			gomega.Expect(k8sClient.Get(ctx, denyKey, deny)).To(gomega.Succeed())
+			gomega.Expect(deny.Spec.PolicyTypes).To(gomega.ConsistOf(networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress))
+			gomega.Expect(deny.Spec.Ingress).To(gomega.BeEmpty())
+
+			ginkgo.By("Reverting changes made to a managed NetworkPolicy by another field manager")
+			deny.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+			gomega.Expect(k8sClient.Update(ctx, deny, client.FieldOwner("someone-else"))).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, denyKey, deny)).To(gomega.Succeed())
+			gomega.Expect(deny.Spec.PolicyTypes).To(gomega.ConsistOf(networkingv1.PolicyTypeIngress,
+				networkingv1.PolicyTypeEgress))
+
+			ginkgo.By("Relaxing the profile to Baseline")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			org.Spec.Namespace.NetworkIsolation = securityv1beta1.NetworkIsolationBaseline
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(policyNames()).To(gomega.ConsistOf(defaultDenyPolicyName, allowSameNamespacePolicyName,
+				allowManagementPolicyName))
+			gomega.Expect(k8sClient.Get(ctx, denyKey, deny)).To(gomega.Succeed())
+			gomega.Expect(deny.Spec.PolicyTypes).To(gomega.ConsistOf(networkingv1.PolicyTypeIngress))
+
+			ginkgo.By("Disabling network isolation")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			org.Spec.Namespace.NetworkIsolation = securityv1beta1.NetworkIsolationNone
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(policyNames()).To(gomega.BeEmpty())
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When an Organization configures Pod Security Admission", func() {
+		ginkgo.It("Should set the pod-security.kubernetes.io labels and remove them when unset", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-pod-security",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						PodSecurity: &securityv1beta1.PodSecurity{
+							Enforce:        securityv1beta1.PodSecurityLevelBaseline,
+							EnforceVersion: "v1.31",
+							Warn:           securityv1beta1.PodSecurityLevelRestricted,
+						},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			namespace := &corev1.Namespace{}
+			namespaceKey := client.ObjectKey{Name: "org-test-pod-security"}
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("pod-security.kubernetes.io/enforce", "baseline"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("pod-security.kubernetes.io/enforce-version", "v1.31"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("pod-security.kubernetes.io/warn", "restricted"))
+			gomega.Expect(namespace.Labels).NotTo(gomega.HaveKey("pod-security.kubernetes.io/audit"))
+
+			ginkgo.By("Removing the Pod Security configuration")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			org.Spec.Namespace.PodSecurity = nil
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			for key := range namespace.Labels {
+				gomega.Expect(key).NotTo(gomega.HavePrefix(securityv1beta1.PodSecurityLabelPrefix))
+			}
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When the namespace naming template changes", func() {
+		ginkgo.It("Should keep the recorded namespace until a migration is requested", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-rename",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							},
+						},
+					},
+					Access: []securityv1beta1.AccessBinding{
+						{ClusterRole: "admin", Groups: []string{"customer:admins"}},
+					},
+					ServiceAccounts: []securityv1beta1.ServiceAccount{
+						{Name: "ci", ClusterRoles: []string{"edit"}},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ProtectedKinds = protection.DefaultKinds
+			})
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			ginkgo.By("Switching to another naming template")
+			template, err := naming.Parse("tenant-{{ .Name }}")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			reconciler.NamespaceTemplate = template
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.Equal("org-test-rename"))
+			err = k8sClient.Get(ctx, client.ObjectKey{Name: "tenant-test-rename"}, &corev1.Namespace{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			ginkgo.By("Requesting the migration while the old namespace contains a Cluster")
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(protection.DefaultKinds[0])
+			cluster.SetNamespace("org-test-rename")
+			cluster.SetName("workload")
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+
+			org.Annotations = map[string]string{securityv1beta1.MigrateNamespaceAnnotation: "true"}
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			result := reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.Equal("org-test-rename"))
+			ready := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionReady)
+			gomega.Expect(ready.Reason).To(gomega.Equal(securityv1beta1.ReasonMigrationBlocked))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonMigrationBlocked)))
+
+			ginkgo.By("Migrating once the Cluster is gone")
+			gomega.Expect(k8sClient.Delete(ctx, cluster)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Namespace).To(gomega.Equal("tenant-test-rename"))
+			gomega.Expect(meta.IsStatusConditionTrue(org.Status.Conditions, securityv1beta1.ConditionReady)).To(gomega.BeTrue())
+
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "tenant-test-rename"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(metav1.IsControlledBy(namespace, org)).To(gomega.BeTrue())
+
+			previous := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-rename"}, previous)).To(gomega.Succeed())
+			gomega.Expect(previous.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(previous.Labels).NotTo(gomega.HaveKey(securityv1beta1.ManagedByLabel))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceMigrated)))
+
+			ginkgo.By("Moving the managed objects out of the old namespace")
+			for _, namespaceName := range []string{"org-test-rename", "tenant-test-rename"} {
+				roleBindings := &rbacv1.RoleBindingList{}
+				gomega.Expect(k8sClient.List(ctx, roleBindings, client.InNamespace(namespaceName))).To(gomega.Succeed())
+				serviceAccounts := &corev1.ServiceAccountList{}
+				gomega.Expect(k8sClient.List(ctx, serviceAccounts, client.InNamespace(namespaceName))).To(gomega.Succeed())
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: resourceQuotaName},
+					&corev1.ResourceQuota{})
+				if namespaceName == "org-test-rename" {
+					gomega.Expect(roleBindings.Items).To(gomega.BeEmpty())
+					gomega.Expect(serviceAccounts.Items).To(gomega.BeEmpty())
+					gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+				} else {
+					gomega.Expect(roleBindings.Items).To(gomega.HaveLen(2))
+					gomega.Expect(serviceAccounts.Items).To(gomega.HaveLen(1))
+					gomega.Expect(err).NotTo(gomega.HaveOccurred())
+				}
+			}
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When an Organization declares display metadata", func() {
+		ginkgo.It("Should mirror it into the Namespace annotations", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-display",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					DisplayName:   "Example Corp",
+					Description:   "Example customer",
+					OwnerEmails:   []string{"owner@example.com", "cto@example.com"},
+					ContactEmails: []string{"oncall@example.com"},
+					SupportTier:   securityv1beta1.SupportTierPremium,
+					CustomerIDs: []securityv1beta1.CustomerID{
+						{System: "crm", ID: "0012345"},
+						{System: "billing", ID: "B-42"},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			namespace := &corev1.Namespace{}
+			namespaceKey := client.ObjectKey{Name: "org-test-display"}
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Annotations).To(gomega.SatisfyAll(
+				gomega.HaveKeyWithValue(securityv1beta1.DisplayNameAnnotation, "Example Corp"),
+				gomega.HaveKeyWithValue(securityv1beta1.DescriptionAnnotation, "Example customer"),
+				gomega.HaveKeyWithValue(securityv1beta1.OwnerEmailsAnnotation, "owner@example.com,cto@example.com"),
+				gomega.HaveKeyWithValue(securityv1beta1.ContactEmailsAnnotation, "oncall@example.com"),
+				gomega.HaveKeyWithValue(securityv1beta1.SupportTierAnnotation, "Premium"),
+				gomega.HaveKeyWithValue(securityv1beta1.CustomerIDsAnnotation, "crm=0012345,billing=B-42"),
+			))
+
+			ginkgo.By("Clearing some of the metadata")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			org.Spec.Description = ""
+			org.Spec.CustomerIDs = nil
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Annotations).NotTo(gomega.HaveKey(securityv1beta1.DescriptionAnnotation))
+			gomega.Expect(namespace.Annotations).NotTo(gomega.HaveKey(securityv1beta1.CustomerIDsAnnotation))
+			gomega.Expect(namespace.Annotations).To(gomega.HaveKeyWithValue(securityv1beta1.DisplayNameAnnotation,
+				"Example Corp"))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When an Organization is suspended", func() {
+		ginkgo.It("Should freeze quota and write access and restore them when resumed", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-suspend",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							},
+						},
+					},
+					Access: []securityv1beta1.AccessBinding{
+						{ClusterRole: "admin", Groups: []string{"customer:admins"}},
+						{ClusterRole: "view", Groups: []string{"customer:auditors"}},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ReadOnlyClusterRoles = DefaultReadOnlyClusterRoles
+			})
+			setSuspended := func(suspended bool) {
+				gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+				org.Spec.Suspended = suspended
+				// The fake client does not bump the generation on spec changes
+				org.Generation++
+				gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+				reconcileOrganization(ctx, reconciler, org.Name)
+				gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			}
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			quota := &corev1.ResourceQuota{}
+			quotaKey := client.ObjectKey{Namespace: "org-test-suspend", Name: resourceQuotaName}
+			namespace := &corev1.Namespace{}
+			namespaceKey := client.ObjectKey{Name: "org-test-suspend"}
+
+			ginkgo.By("Suspending the organization")
+			setSuspended(true)
+
+			gomega.Expect(k8sClient.Get(ctx, quotaKey, quota)).To(gomega.Succeed())
+			gomega.Expect(quota.Spec.Hard).To(gomega.HaveKeyWithValue(corev1.ResourceRequestsCPU, resource.MustParse("0")))
+			gomega.Expect(quota.Spec.Hard).To(gomega.HaveKeyWithValue(corev1.ResourcePods, resource.MustParse("0")))
+			gomega.Expect(org.Status.AccessRoleBindings).To(gomega.Equal([]string{"organization-access-view"}))
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(securityv1beta1.SuspendedLabel, "true"))
+			gomega.Expect(meta.IsStatusConditionTrue(org.Status.Conditions, securityv1beta1.ConditionSuspended)).
+				To(gomega.BeTrue())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceUpdated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonSuspended)))
+
+			ginkgo.By("Resuming the organization")
+			setSuspended(false)
+
+			gomega.Expect(k8sClient.Get(ctx, quotaKey, quota)).To(gomega.Succeed())
+			gomega.Expect(quota.Spec.Hard).To(gomega.Equal(corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("10"),
+			}))
+			gomega.Expect(org.Status.AccessRoleBindings).To(gomega.Equal([]string{
+				"organization-access-admin", "organization-access-view",
+			}))
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).NotTo(gomega.HaveKey(securityv1beta1.SuspendedLabel))
+			gomega.Expect(meta.IsStatusConditionFalse(org.Status.Conditions, securityv1beta1.ConditionSuspended)).
+				To(gomega.BeTrue())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceUpdated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNotSuspended)))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When an Organization has a parent", func() {
+		ginkgo.It("Should inherit access, labels and quota ceilings and block deleting the parent", func() {
+			ctx := context.Background()
+
+			parent := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-parent",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels: map[string]string{"billing": "enterprise", "team": "parent"},
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU:    resource.MustParse("10"),
+								corev1.ResourceRequestsMemory: resource.MustParse("20Gi"),
+							},
+						},
+					},
+					Access: []securityv1beta1.AccessBinding{
+						{ClusterRole: "admin", Groups: []string{"enterprise:admins"}},
+					},
+				},
+			}
+			child := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-child",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Parent: parent.Name,
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels: map[string]string{"team": "child"},
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("50"),
+							},
+						},
+					},
+					Access: []securityv1beta1.AccessBinding{
+						{ClusterRole: "admin", Groups: []string{"unit:admins"}},
+					},
+				},
+			}
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+
+			ginkgo.By("Reconciling the child before its parent exists")
+			gomega.Expect(k8sClient.Create(ctx, child)).To(gomega.Succeed())
+			result := reconcileOrganization(ctx, reconciler, child.Name)
+			gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(child), child)).To(gomega.Succeed())
+			ready := meta.FindStatusCondition(child.Status.Conditions, securityv1beta1.ConditionReady)
+			gomega.Expect(ready.Reason).To(gomega.Equal(securityv1beta1.ReasonParentNotFound))
+
+			ginkgo.By("Creating the parent")
+			gomega.Expect(k8sClient.Create(ctx, parent)).To(gomega.Succeed())
+			gomega.Expect(reconciler.descendantRequests(ctx, parent)).To(gomega.ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: child.Name}}))
+			reconcileOrganization(ctx, reconciler, parent.Name)
+			reconcileOrganization(ctx, reconciler, child.Name)
+
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-test-child"}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("billing", "enterprise"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("team", "child"))
+
+			quota := &corev1.ResourceQuota{}
+			quotaKey := client.ObjectKey{Namespace: "org-test-child", Name: resourceQuotaName}
+			gomega.Expect(k8sClient.Get(ctx, quotaKey, quota)).To(gomega.Succeed())
+			gomega.Expect(quota.Spec.Hard).To(gomega.Equal(corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("10"),
+				corev1.ResourceRequestsMemory: resource.MustParse("20Gi"),
+			}))
+
+			roleBinding := &rbacv1.RoleBinding{}
+			roleBindingKey := client.ObjectKey{Namespace: "org-test-child", Name: "organization-access-admin"}
+			gomega.Expect(k8sClient.Get(ctx, roleBindingKey, roleBinding)).To(gomega.Succeed())
+			gomega.Expect(roleBinding.Subjects).To(gomega.ConsistOf(
+				rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "unit:admins"},
+				rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: "enterprise:admins"},
+			))
+
+			ginkgo.By("Deleting the parent while the child exists")
+			gomega.Expect(k8sClient.Delete(ctx, parent)).To(gomega.Succeed())
+			result = reconcileOrganization(ctx, reconciler, parent.Name)
+			gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(parent), parent)).To(gomega.Succeed())
+			deleting := meta.FindStatusCondition(parent.Status.Conditions, securityv1beta1.ConditionDeleting)
+			gomega.Expect(deleting.Reason).To(gomega.Equal(securityv1beta1.ReasonHasChildren))
+			gomega.Expect(deleting.Message).To(gomega.ContainSubstring(child.Name))
+
+			ginkgo.By("Deleting the child first")
+			gomega.Expect(k8sClient.Delete(ctx, child)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, child.Name)
+			reconcileOrganization(ctx, reconciler, child.Name)
+			reconcileOrganization(ctx, reconciler, parent.Name)
+			reconcileOrganization(ctx, reconciler, parent.Name)
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(parent), parent)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.Context("When an Organization declares ServiceAccounts", func() {
+		ginkgo.It("Should leave a ServiceAccount created by someone else alone", func() {
+			ctx := context.Background()
+			namespaceName := "org-test-tenant-service-account"
+
+			tenant := &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ci",
+					Namespace: namespaceName,
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, tenant)).To(gomega.Succeed())
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-tenant-service-account",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					ServiceAccounts: []securityv1beta1.ServiceAccount{
+						{Name: "ci", ClusterRoles: []string{"edit"}, Token: true},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(tenant), tenant)).To(gomega.Succeed())
+			gomega.Expect(tenant.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(tenant.Labels).NotTo(gomega.HaveKey(componentLabel))
+			err := k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: namespaceName, Name: "organization-service-account-ci.edit",
+			}, &rbacv1.RoleBinding{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			err = k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: "ci-token"}, &corev1.Secret{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.ServiceAccounts).To(gomega.BeEmpty())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+				gomega.HavePrefix(corev1.EventTypeWarning),
+				gomega.ContainSubstring(securityv1beta1.ReasonServiceAccountConflict),
+			)))
+
+			ginkgo.By("Removing the ServiceAccount from the spec")
+			org.Spec.ServiceAccounts = nil
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(tenant), tenant)).To(gomega.Succeed())
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should provision, bind and report them, and remove those no longer declared", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-service-accounts",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					ServiceAccounts: []securityv1beta1.ServiceAccount{
+						{Name: "ci", ClusterRoles: []string{"edit", "view"}, Token: true},
+						{Name: "deploy"},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler(func(r *OrganizationReconciler) {
+				r.ReadOnlyClusterRoles = DefaultReadOnlyClusterRoles
+			})
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			namespaceName := "org-test-service-accounts"
+			for _, name := range []string{"ci", "deploy"} {
+				serviceAccount := &corev1.ServiceAccount{}
+				gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: name},
+					serviceAccount)).To(gomega.Succeed())
+				gomega.Expect(serviceAccount.Labels).To(gomega.HaveKeyWithValue(componentLabel, serviceAccountComponent))
+			}
+			roleBinding := &rbacv1.RoleBinding{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: namespaceName, Name: "organization-service-account-ci.edit",
+			}, roleBinding)).To(gomega.Succeed())
+			gomega.Expect(roleBinding.RoleRef.Name).To(gomega.Equal("edit"))
+			gomega.Expect(roleBinding.Subjects).To(gomega.ConsistOf(rbacv1.Subject{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      "ci",
+				Namespace: namespaceName,
+			}))
+			token := &corev1.Secret{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: "ci-token"},
+				token)).To(gomega.Succeed())
+			gomega.Expect(token.Type).To(gomega.Equal(corev1.SecretTypeServiceAccountToken))
+			gomega.Expect(token.Annotations).To(gomega.HaveKeyWithValue(corev1.ServiceAccountNameKey, "ci"))
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.ServiceAccounts).To(gomega.Equal([]securityv1beta1.ServiceAccountStatus{
+				{Name: "ci", TokenSecret: "ci-token"},
+				{Name: "deploy"},
+			}))
+
+			ginkgo.By("Suspending the Organization")
+			org.Spec.Suspended = true
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			err := k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: namespaceName, Name: "organization-service-account-ci.edit",
+			}, &rbacv1.RoleBinding{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: namespaceName, Name: "organization-service-account-ci.view",
+			}, &rbacv1.RoleBinding{})).To(gomega.Succeed())
+
+			ginkgo.By("Removing a ServiceAccount and the token")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			org.Spec.Suspended = false
+			org.Spec.ServiceAccounts = []securityv1beta1.ServiceAccount{{Name: "ci", ClusterRoles: []string{"edit"}}}
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			for _, object := range []client.Object{
+				&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespaceName, Name: "deploy"}},
+				&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespaceName, Name: "ci-token"}},
+				&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespaceName, Name: "organization-service-account-ci.view",
+				}},
+			} {
+				err := k8sClient.Get(ctx, client.ObjectKeyFromObject(object), object)
+				gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{
+				Namespace: namespaceName, Name: "organization-service-account-ci.edit",
+			}, &rbacv1.RoleBinding{})).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.ServiceAccounts).To(gomega.Equal([]securityv1beta1.ServiceAccountStatus{{Name: "ci"}}))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+
+		ginkgo.It("Should give every ServiceAccount and ClusterRole pair its own RoleBinding", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-service-account-names",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					ServiceAccounts: []securityv1beta1.ServiceAccount{
+						{Name: "a-b", ClusterRoles: []string{"c"}},
+						{Name: "a", ClusterRoles: []string{"b-c"}},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			var roleBindings rbacv1.RoleBindingList
+			gomega.Expect(k8sClient.List(ctx, &roleBindings, client.InNamespace("org-test-service-account-names"),
+				client.MatchingLabels{componentLabel: serviceAccountComponent})).To(gomega.Succeed())
+			subjects := map[string]string{}
+			for _, roleBinding := range roleBindings.Items {
+				subjects[roleBinding.RoleRef.Name] = roleBinding.Subjects[0].Name
+			}
+			gomega.Expect(subjects).To(gomega.Equal(map[string]string{"c": "a-b", "b-c": "a"}))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When Cluster API clusters exist in the Namespace", func() {
+		ginkgo.It("Should summarize them in the status and requeue the Organization when they change", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-clusters",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Clusters).NotTo(gomega.BeNil())
+			gomega.Expect(org.Status.Clusters.Count).To(gomega.BeZero())
+			gomega.Expect(org.Status.Clusters.Items).To(gomega.BeEmpty())
+
+			newCluster := func(name, phase string) *unstructured.Unstructured {
+				cluster := &unstructured.Unstructured{}
+				cluster.SetGroupVersionKind(protection.ClusterKind)
+				cluster.SetNamespace("org-test-clusters")
+				cluster.SetName(name)
+				if phase != "" {
+					gomega.Expect(unstructured.SetNestedField(cluster.Object, phase, "status", "phase")).To(gomega.Succeed())
+				}
+				return cluster
+			}
+			staging := newCluster("staging", "Provisioning")
+			gomega.Expect(k8sClient.Create(ctx, newCluster("production", "Provisioned"))).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Create(ctx, staging)).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Create(ctx, newCluster("dev", ""))).To(gomega.Succeed())
+
+			ginkgo.By("Mapping the clusters to the Organization owning the Namespace")
+			gomega.Expect(reconciler.clusterRequests(ctx, staging)).To(gomega.ConsistOf(reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: org.Name},
+			}))
+			unmanaged := newCluster("unmanaged", "")
+			unmanaged.SetNamespace("default")
+			gomega.Expect(reconciler.clusterRequests(ctx, unmanaged)).To(gomega.BeEmpty())
+
+			ginkgo.By("Only passing updates that change the phase")
+			provisioned := newCluster("staging", "Provisioned")
+			gomega.Expect(clusterChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: staging, ObjectNew: provisioned,
+			})).To(gomega.BeTrue())
+			provisioned.SetLabels(map[string]string{"team": "platform"})
+			gomega.Expect(clusterChangedPredicate().Update(event.UpdateEvent{
+				ObjectOld: newCluster("staging", "Provisioned"), ObjectNew: provisioned,
+			})).To(gomega.BeFalse())
+
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Clusters).To(gomega.Equal(&securityv1beta1.ClustersStatus{
+				Count: 3,
+				Items: []securityv1beta1.ClusterSummary{
+					{Name: "dev"},
+					{Name: "production", Phase: "Provisioned"},
+					{Name: "staging", Phase: "Provisioning"},
+				},
+			}))
+
+			ginkgo.By("Removing deleted clusters from the summary")
+			gomega.Expect(k8sClient.Delete(ctx, staging)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Clusters.Count).To(gomega.Equal(int32(2)))
+			gomega.Expect(org.Status.Clusters.Items).NotTo(gomega.ContainElement(
+				securityv1beta1.ClusterSummary{Name: "staging", Phase: "Provisioning"}))
+
+			for _, name := range []string{"dev", "production"} {
+				gomega.Expect(k8sClient.Delete(ctx, newCluster(name, ""))).To(gomega.Succeed())
+			}
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When Secrets and ConfigMaps are replicated", func() {
+		ginkgo.It("Should copy annotated sources into the selected Namespaces and keep them in sync", func() {
+			ctx := context.Background()
+			const sourceNamespace = "replication-source"
+
+			pullSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pull-secret",
+					Namespace:   sourceNamespace,
+					Annotations: map[string]string{securityv1beta1.ReplicateAnnotation: "true"},
+				},
+				Type: corev1.SecretTypeDockerConfigJson,
+				Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+			}
+			registrySecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "registry",
+					Namespace:   sourceNamespace,
+					Annotations: map[string]string{securityv1beta1.ReplicateAnnotation: "true"},
+				},
+				Data: map[string][]byte{"token": []byte("shared")},
+			}
+			privateSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "private",
+					Namespace: sourceNamespace,
+				},
+			}
+			caBundle := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca-bundle",
+					Namespace: sourceNamespace,
+					Annotations: map[string]string{
+						securityv1beta1.ReplicateAnnotation:         "true",
+						securityv1beta1.ReplicateSelectorAnnotation: "team=platform",
+					},
+				},
+				Data: map[string]string{"ca.crt": "certificate"},
+			}
+			for _, source := range []client.Object{pullSecret, registrySecret, privateSecret, caBundle} {
+				gomega.Expect(k8sClient.Create(ctx, source)).To(gomega.Succeed())
+			}
+
+			ginkgo.By("Creating a Secret of the same name in one of the Namespaces")
+			foreign := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "registry",
+					Namespace: "org-test-replication-other",
+				},
+				Data: map[string][]byte{"token": []byte("own")},
+			}
+			gomega.Expect(k8sClient.Create(ctx, foreign)).To(gomega.Succeed())
+
+			platform := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-replication",
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels: map[string]string{"team": "platform"},
+					},
+				},
+			}
+			other := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-replication-other",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, platform)).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Create(ctx, other)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ReplicationNamespace = sourceNamespace
+			})
+			reconcileOrgs := func() {
+				for _, name := range []string{platform.Name, other.Name} {
+					reconcileOrganization(ctx, reconciler, name)
+				}
+			}
+			reconcileOrgs()
+
+			replica := &corev1.Secret{}
+			for _, namespaceName := range []string{"org-test-replication", "org-test-replication-other"} {
+				gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: "pull-secret"},
+					replica)).To(gomega.Succeed())
+				gomega.Expect(replica.Type).To(gomega.Equal(corev1.SecretTypeDockerConfigJson))
+				gomega.Expect(replica.Data).To(gomega.Equal(pullSecret.Data))
+				gomega.Expect(replica.Annotations).To(
+					gomega.HaveKeyWithValue(securityv1beta1.ReplicatedFromAnnotation, sourceNamespace+"/pull-secret"))
+				gomega.Expect(replica.Labels).To(gomega.HaveKeyWithValue(componentLabel, replicationComponent))
+				err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespaceName, Name: "private"}, &corev1.Secret{})
+				gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication", Name: "ca-bundle"},
+				&corev1.ConfigMap{})).To(gomega.Succeed())
+			err := k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication-other", Name: "ca-bundle"},
+				&corev1.ConfigMap{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			ginkgo.By("Leaving the Secret of the same name alone")
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(foreign), foreign)).To(gomega.Succeed())
+			gomega.Expect(foreign.Data).To(gomega.HaveKeyWithValue("token", []byte("own")))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+				gomega.HavePrefix(corev1.EventTypeWarning),
+				gomega.ContainSubstring(securityv1beta1.ReasonReplicaConflict),
+			)))
+
+			ginkgo.By("Updating a source")
+			pullSecret.Data[corev1.DockerConfigJsonKey] = []byte(`{"auths":{"example.com":{}}}`)
+			gomega.Expect(k8sClient.Update(ctx, pullSecret)).To(gomega.Succeed())
+			gomega.Expect(reconciler.replicationSourcePredicate().Update(event.UpdateEvent{
+				ObjectOld: pullSecret,
+				ObjectNew: pullSecret,
+			})).To(gomega.BeTrue())
+			gomega.Expect(reconciler.replicationRequests(ctx, pullSecret)).To(gomega.ContainElements(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: platform.Name}},
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: other.Name}},
+			))
+			reconcileOrgs()
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication", Name: "pull-secret"},
+				replica)).To(gomega.Succeed())
+			gomega.Expect(replica.Data).To(gomega.Equal(pullSecret.Data))
+
+			ginkgo.By("Removing the replication annotation and deleting a source")
+			oldPullSecret := pullSecret.DeepCopy()
+			delete(pullSecret.Annotations, securityv1beta1.ReplicateAnnotation)
+			gomega.Expect(k8sClient.Update(ctx, pullSecret)).To(gomega.Succeed())
+			gomega.Expect(reconciler.replicationSourcePredicate().Update(event.UpdateEvent{
+				ObjectOld: oldPullSecret,
+				ObjectNew: pullSecret,
+			})).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Delete(ctx, caBundle)).To(gomega.Succeed())
+			reconcileOrgs()
+			err = k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication", Name: "pull-secret"}, replica)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			err = k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication", Name: "ca-bundle"},
+				&corev1.ConfigMap{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: "org-test-replication", Name: "registry"},
+				replica)).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(foreign), foreign)).To(gomega.Succeed())
+
+			gomega.Expect(k8sClient.Delete(ctx, platform)).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Delete(ctx, other)).To(gomega.Succeed())
+			reconcileOrgs()
+			reconcileOrgs()
+		})
+
+		ginkgo.It("Should not take over an object of the same name that is not a replica", func() {
+			ctx := context.Background()
+			const sourceNamespace = "replication-takeover-source"
+
+			source := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "settings",
+					Namespace:   sourceNamespace,
+					Annotations: map[string]string{securityv1beta1.ReplicateAnnotation: "true"},
+				},
+				Data: map[string]string{"region": "eu"},
+			}
+			gomega.Expect(k8sClient.Create(ctx, source)).To(gomega.Succeed())
+
+			ginkgo.By("Creating a ConfigMap of the same name with the same data in the Namespace")
+			owned := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "settings",
+					Namespace: "org-test-replication-takeover",
+				},
+				Data: map[string]string{"region": "eu"},
+			}
+			gomega.Expect(k8sClient.Create(ctx, owned)).To(gomega.Succeed())
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-replication-takeover",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ReplicationNamespace = sourceNamespace
+			})
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(owned), owned)).To(gomega.Succeed())
+			gomega.Expect(owned.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(owned.Annotations).NotTo(gomega.HaveKey(securityv1beta1.ReplicatedFromAnnotation))
+			gomega.Expect(owned.Labels).NotTo(gomega.HaveKey(componentLabel))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+				gomega.HavePrefix(corev1.EventTypeWarning),
+				gomega.ContainSubstring(securityv1beta1.ReasonReplicaConflict),
+			)))
+
+			ginkgo.By("Removing the replication annotation of the source")
+			delete(source.Annotations, securityv1beta1.ReplicateAnnotation)
+			gomega.Expect(k8sClient.Update(ctx, source)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(owned), owned)).To(gomega.Succeed())
+			gomega.Expect(owned.Data).To(gomega.HaveKeyWithValue("region", "eu"))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When the Namespace cannot be created", func() {
+		ginkgo.It("Should report the failure in the Organization conditions", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-namespace-failure",
+				},
+			}
+			failingClient := interceptor.NewClient(k8sClient.(client.WithWatch), interceptor.Funcs{
+				Apply: func(ctx context.Context, c client.WithWatch, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error { //nolint:lll
+					if kind, ok := obj.(interface{ GetKind() string }); ok && kind.GetKind() == "Namespace" {
+						return fmt.Errorf("namespace creation is forbidden")
+					}
+					return c.Apply(ctx, obj, opts...)
+				},
+			})
+			gomega.Expect(failingClient.Create(ctx, org)).To(gomega.Succeed())
+			failures := testutil.ToFloat64(reconcilePhaseTotal.WithLabelValues(phaseNamespace, outcomeError))
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.Client = failingClient
+			})
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: org.Name},
+			})
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			gomega.Expect(testutil.ToFloat64(reconcilePhaseTotal.WithLabelValues(phaseNamespace, outcomeError))).To(
+				gomega.Equal(failures + 1))
+
+			updatedOrg := &securityv1beta1.Organization{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, updatedOrg)).To(gomega.Succeed())
+			ready := meta.FindStatusCondition(updatedOrg.Status.Conditions, securityv1beta1.ConditionReady)
+			gomega.Expect(ready).NotTo(gomega.BeNil())
+			gomega.Expect(ready.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(ready.Reason).To(gomega.Equal(securityv1beta1.ReasonNamespaceFailed))
+			gomega.Expect(meta.IsStatusConditionFalse(updatedOrg.Status.Conditions,
+				securityv1beta1.ConditionNamespaceReady)).To(gomega.BeTrue())
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.And(
+				gomega.HavePrefix(corev1.EventTypeWarning),
+				gomega.ContainSubstring(securityv1beta1.ReasonReconcileFailed),
+				gomega.ContainSubstring("namespace creation is forbidden"),
+			)))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			cleanupReconciler := newReconciler()
+			reconcileOrganization(ctx, cleanupReconciler, org.Name)
+		})
+	})
+
+	ginkgo.Context("When the Namespace still contains protected resources", func() {
+		ginkgo.It("Should block the deletion until the override annotation is set", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-protected",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ProtectedKinds = protection.DefaultKinds
+			})
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(protection.DefaultKinds[0])
+			cluster.SetNamespace("org-test-protected")
+			cluster.SetName("workload")
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+
+			ginkgo.By("Deleting the organization while a Cluster exists")
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			result := reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+
+			protectedKey := client.ObjectKey{Name: "org-test-protected"}
+			gomega.Expect(k8sClient.Get(ctx, protectedKey, &corev1.Namespace{})).To(gomega.Succeed())
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			deleting := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionDeleting)
+			gomega.Expect(deleting).NotTo(gomega.BeNil())
+			gomega.Expect(deleting.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(deleting.Reason).To(gomega.Equal(securityv1beta1.ReasonDeletionBlocked))
+			gomega.Expect(deleting.Message).To(gomega.ContainSubstring("Cluster/workload"))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionStarted)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionBlocked)))
+			gomega.Expect(org.Status.Teardown).To(gomega.Equal(&securityv1beta1.TeardownStatus{
+				Stage:              securityv1beta1.TeardownStageBlocked,
+				RemainingResources: []string{"Cluster/workload"},
+			}))
+
+			ginkgo.By("Setting the override annotation")
+			org.Annotations = map[string]string{securityv1beta1.AllowDeletionAnnotation: "true"}
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			err := k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, &securityv1beta1.Organization{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(k8sClient.Delete(ctx, cluster)).To(gomega.Succeed())
+		})
+	})
+
+	ginkgo.Context("When an Organization is torn down", func() {
+		ginkgo.It("Should delete clusters and Apps before the Namespace and report a stuck deletion", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-teardown",
+					Annotations: map[string]string{securityv1beta1.AllowDeletionAnnotation: "true"},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder), func(r *OrganizationReconciler) {
+				r.ProtectedKinds = protection.DefaultKinds
+				r.TeardownKinds = DefaultTeardownKinds
+				r.DeletionTimeout = time.Hour
+			})
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			// The finalizer keeps the Cluster around until the test removes it
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(DefaultTeardownKinds[0])
+			cluster.SetNamespace("org-test-teardown")
+			cluster.SetName("workload")
+			cluster.SetFinalizers([]string{"cluster.cluster.x-k8s.io"})
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+			app := &unstructured.Unstructured{}
+			app.SetGroupVersionKind(DefaultTeardownKinds[1])
+			app.SetNamespace("org-test-teardown")
+			app.SetName("workload-cilium")
+			gomega.Expect(k8sClient.Create(ctx, app)).To(gomega.Succeed())
+
+			ginkgo.By("Deleting the clusters and Apps first")
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			result := reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(result.RequeueAfter).To(gomega.BeNumerically(">", 0))
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(gomega.Succeed())
+			gomega.Expect(cluster.GetDeletionTimestamp()).NotTo(gomega.BeNil())
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(app), app)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			namespaceKey := client.ObjectKey{Name: "org-test-teardown"}
+			gomega.Expect(k8sClient.Get(ctx, namespaceKey, &corev1.Namespace{})).To(gomega.Succeed())
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Teardown.Stage).To(gomega.Equal(securityv1beta1.TeardownStageDeletingResources))
+			gomega.Expect(org.Status.Teardown.RemainingResources).To(gomega.ConsistOf("Cluster/workload", "App/workload-cilium"))
+			deleting := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionDeleting)
+			gomega.Expect(deleting.Reason).To(gomega.Equal(securityv1beta1.ReasonDeletingResources))
+			stuck := meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionDeletionStuck)
+			gomega.Expect(stuck.Status).To(gomega.Equal(metav1.ConditionFalse))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionStarted)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletingResources)))
+
+			ginkgo.By("Waiting for the Cluster without repeating the stage event")
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Teardown.RemainingResources).To(gomega.Equal([]string{"Cluster/workload"}))
+			gomega.Expect(recorder.Events).NotTo(gomega.Receive())
+
+			ginkgo.By("Exceeding the deletion timeout")
+			requeued := testutil.ToFloat64(reconcilePhaseTotal.WithLabelValues(phaseDelete, outcomeRequeue))
+			reconciler.DeletionTimeout = time.Nanosecond
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(testutil.ToFloat64(reconcilePhaseTotal.WithLabelValues(phaseDelete, outcomeRequeue))).To(
+				gomega.Equal(requeued + 1))
+			gomega.Expect(organizationMetric("organization_deletion_requested_seconds", org.Name)).To(
+				gomega.BeNumerically(">", 0))
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			stuck = meta.FindStatusCondition(org.Status.Conditions, securityv1beta1.ConditionDeletionStuck)
+			gomega.Expect(stuck.Status).To(gomega.Equal(metav1.ConditionTrue))
+			gomega.Expect(stuck.Reason).To(gomega.Equal(securityv1beta1.ReasonDeletionTimeoutExceeded))
+			gomega.Expect(stuck.Message).To(gomega.ContainSubstring("Cluster/workload"))
+			gomega.Expect(recorder.Events).To(gomega.Receive(
+				gomega.ContainSubstring(securityv1beta1.ReasonDeletionTimeoutExceeded)))
+			gomega.Expect(testutil.ToFloat64(organizationDeletionStuck.WithLabelValues(org.Name))).To(gomega.Equal(1.0))
+
+			ginkgo.By("Deleting the Namespace once the Cluster is gone")
+			cluster.SetFinalizers(nil)
+			gomega.Expect(k8sClient.Update(ctx, cluster)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Status.Teardown.Stage).To(gomega.Equal(securityv1beta1.TeardownStageDeletingNamespace))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceDeleting)))
+			err = k8sClient.Get(ctx, namespaceKey, &corev1.Namespace{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			reconcileOrganization(ctx, reconciler, org.Name)
+			err = k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+			gomega.Expect(testutil.CollectAndCount(organizationDeletionStuck)).To(gomega.Equal(0))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionCompleted)))
+		})
+
+		ginkgo.It("Should stop reporting a stuck deletion of an Organization that is gone", func() {
+			ctx := context.Background()
+
+			// The Organization was removed without its deletion being
+			// reconciled to completion, e.g. by removing its finalizer
+			organizationDeletionStuck.WithLabelValues("test-teardown-removed").Set(1)
+
+			reconciler := newReconciler()
+			reconcileOrganization(ctx, reconciler, "test-teardown-removed")
+			gomega.Expect(organizationDeletionStuck.DeleteLabelValues("test-teardown-removed")).To(gomega.BeFalse())
+		})
+	})
+
+	ginkgo.Context("When an Organization keeps its Namespace on deletion", func() {
+		testNamespaceRelease := func(ctx context.Context, name string,
+			policy securityv1beta1.DeletionPolicy) *corev1.Namespace {
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: securityv1beta1.OrganizationSpec{
+					DisplayName: "Platform",
+					Namespace: securityv1beta1.NamespaceSpec{
+						Labels:         map[string]string{"team": "platform"},
+						DeletionPolicy: policy,
+						ResourceQuota: &corev1.ResourceQuotaSpec{
+							Hard: corev1.ResourceList{
+								corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							},
+						},
+					},
+					Access: []securityv1beta1.AccessBinding{
+						{ClusterRole: "admin", Groups: []string{"customer:admins"}},
+					},
+					ServiceAccounts: []securityv1beta1.ServiceAccount{
+						{Name: "ci", ClusterRoles: []string{"edit"}, Token: true},
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			reconciler := newReconciler(func(r *OrganizationReconciler) {
+				r.ProtectedKinds = protection.DefaultKinds
+			})
+			reconcileOrganization(ctx, reconciler, name)
+
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(protection.DefaultKinds[0])
+			cluster.SetNamespace("org-" + name)
+			cluster.SetName("workload")
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, name)
+
+			err := k8sClient.Get(ctx, client.ObjectKey{Name: name}, &securityv1beta1.Organization{})
+			gomega.Expect(errors.IsNotFound(err)).To(gomega.BeTrue())
+
+			namespace := &corev1.Namespace{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: "org-" + name}, namespace)).To(gomega.Succeed())
+			gomega.Expect(namespace.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue("team", "platform"))
+			gomega.Expect(namespace.Labels).To(gomega.HaveKeyWithValue(securityv1beta1.OrganizationLabel, name))
+
+			var roleBindings rbacv1.RoleBindingList
+			gomega.Expect(k8sClient.List(ctx, &roleBindings, client.InNamespace(namespace.Name))).To(gomega.Succeed())
+			gomega.Expect(roleBindings.Items).To(gomega.HaveLen(2))
+			for _, roleBinding := range roleBindings.Items {
+				gomega.Expect(roleBinding.OwnerReferences).To(gomega.BeEmpty())
+			}
+			var secrets corev1.SecretList
+			gomega.Expect(k8sClient.List(ctx, &secrets, client.InNamespace(namespace.Name))).To(gomega.Succeed())
+			gomega.Expect(secrets.Items).NotTo(gomega.BeEmpty())
+			for _, secret := range secrets.Items {
+				gomega.Expect(secret.OwnerReferences).To(gomega.BeEmpty())
+			}
+			quota := &corev1.ResourceQuota{}
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: resourceQuotaName}, quota)).
+				To(gomega.Succeed())
+			gomega.Expect(quota.OwnerReferences).To(gomega.BeEmpty())
+			return namespace
+		}
+
+		ginkgo.It("Should strip the ownerReference and managed-by label with the Retain policy", func() {
+			namespace := testNamespaceRelease(context.Background(), "test-retain", securityv1beta1.DeletionPolicyRetain)
+			gomega.Expect(namespace.Labels).NotTo(gomega.HaveKey(securityv1beta1.ManagedByLabel))
+			gomega.Expect(namespace.Annotations).NotTo(gomega.HaveKey(securityv1beta1.DisplayNameAnnotation))
+
+			serviceAccount := &corev1.ServiceAccount{}
+			gomega.Expect(k8sClient.Get(context.Background(), client.ObjectKey{Namespace: namespace.Name, Name: "ci"},
+				serviceAccount)).To(gomega.Succeed())
+			gomega.Expect(serviceAccount.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(serviceAccount.Labels).NotTo(gomega.HaveKey(securityv1beta1.ManagedByLabel))
+		})
+
+		ginkgo.It("Should strip only the ownerReference with the Orphan policy", func() {
+			namespace := testNamespaceRelease(context.Background(), "test-orphan", securityv1beta1.DeletionPolicyOrphan)
+			gomega.Expect(namespace.Labels).To(
+				gomega.HaveKeyWithValue(securityv1beta1.ManagedByLabel, securityv1beta1.ManagedByValue),
+			)
+
+			serviceAccount := &corev1.ServiceAccount{}
+			gomega.Expect(k8sClient.Get(context.Background(), client.ObjectKey{Namespace: namespace.Name, Name: "ci"},
+				serviceAccount)).To(gomega.Succeed())
+			gomega.Expect(serviceAccount.OwnerReferences).To(gomega.BeEmpty())
+			gomega.Expect(serviceAccount.Labels).To(
+				gomega.HaveKeyWithValue(securityv1beta1.ManagedByLabel, securityv1beta1.ManagedByValue),
+			)
+		})
+	})
+
 	ginkgo.Context("When handling Organizations with old finalizers", func() {
 		ginkgo.It("Should remove the old finalizer when deleting an Organization", func() {
 			ctx := context.Background()
@@ -242,5 +2066,41 @@ var _ = ginkgo.Describe("Organization controller", func() {
 				"organization with old finalizer still exists",
 			)
 		})
+
+		ginkgo.It("Should replace the old finalizer of a live Organization", func() {
+			ctx := context.Background()
+
+			org := &securityv1beta1.Organization{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-migrate-finalizer",
+					Finalizers: []string{oldFinalizer},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, org)).To(gomega.Succeed())
+
+			recorder := events.NewFakeRecorder(10)
+			reconciler := newReconciler(withRecorder(recorder))
+			reconcileOrganization(ctx, reconciler, org.Name)
+
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKey{Name: org.Name}, org)).To(gomega.Succeed())
+			gomega.Expect(org.Finalizers).To(gomega.Equal([]string{newFinalizer}))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonFinalizerMigrated)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceCreated)))
+
+			ginkgo.By("Updating the namespace labels")
+			org.Spec.Namespace.Labels = map[string]string{"team": "platform"}
+			// The fake client does not bump the generation on spec changes
+			org.Generation++
+			gomega.Expect(k8sClient.Update(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceUpdated)))
+
+			gomega.Expect(k8sClient.Delete(ctx, org)).To(gomega.Succeed())
+			reconcileOrganization(ctx, reconciler, org.Name)
+			reconcileOrganization(ctx, reconciler, org.Name)
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionStarted)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonNamespaceDeleting)))
+			gomega.Expect(recorder.Events).To(gomega.Receive(gomega.ContainSubstring(securityv1beta1.ReasonDeletionCompleted)))
+		})
 	})
 })
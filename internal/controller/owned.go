@@ -0,0 +1,310 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// componentLabel tells apart the different kinds of objects the operator
+// manages in the organization namespace, so that each can be pruned on its own.
+const componentLabel = securityv1beta1.OperatorKeyPrefix + "component"
+
+const (
+	// fieldManager owns the fields the operator applies server-side.
+	fieldManager = "organization-operator"
+	// specFieldManager owns the namespace labels and annotations the operator
+	// copies from the spec, which it does not force over other field managers.
+	specFieldManager = "organization-operator-spec"
+	// legacyFieldManager owned the fields the operator wrote with client-side
+	// updates before it switched to server-side apply.
+	legacyFieldManager = "manager"
+)
+
+// apply applies the fields set in object server-side and updates object with
+// the result. Fields the operator no longer sets are removed, fields set by
+// other field managers are left alone. Unless client.ForceOwnership is passed,
+// fields whose value is owned by another field manager are not overwritten;
+// the conflict is returned instead. Options override the field manager.
+func (r *OrganizationReconciler) apply(ctx context.Context, object client.Object, opts ...client.ApplyOption) error {
+	gvk, err := apiutil.GVKForObject(object, r.Scheme)
+	if err != nil {
+		return err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return err
+	}
+	desired := &unstructured.Unstructured{Object: content}
+	desired.SetGroupVersionKind(gvk)
+	unstructured.RemoveNestedField(desired.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(desired.Object, "status")
+
+	opts = append([]client.ApplyOption{client.FieldOwner(fieldManager)}, opts...)
+	if err := r.Apply(ctx, client.ApplyConfigurationFromUnstructured(desired), opts...); err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(desired.Object, object)
+}
+
+// upgradeManagedFields hands the fields of an existing object that the
+// operator wrote with client-side updates over to its server-side apply field
+// manager, so that they are removed once they are no longer applied rather
+// than kept by the legacy field manager.
+func (r *OrganizationReconciler) upgradeManagedFields(ctx context.Context, object client.Object) error {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(object, sets.New(legacyFieldManager), fieldManager)
+	if err != nil {
+		return err
+	}
+	if patch == nil {
+		return nil
+	}
+	if err := r.Patch(ctx, object, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+		return fmt.Errorf("failed to upgrade managed fields of %T %s: %w",
+			object, client.ObjectKeyFromObject(object), err)
+	}
+	return nil
+}
+
+// applyOwned applies an object the operator manages in the organization
+// namespace, controlled by the organization. The fields of an existing object
+// written with client-side updates are handed over to the server-side apply
+// field manager first. The operator fully owns the object, so it forces
+// ownership and reverts changes made by other field managers.
+func (r *OrganizationReconciler) applyOwned(ctx context.Context, organization *securityv1beta1.Organization, object client.Object) error { //nolint:lll
+	if err := ctrl.SetControllerReference(organization, object, r.Scheme); err != nil {
+		return err
+	}
+
+	gvk, err := apiutil.GVKForObject(object, r.Scheme)
+	if err != nil {
+		return err
+	}
+	current, err := r.Scheme.New(gvk)
+	if err != nil {
+		return err
+	}
+	currentObject, ok := current.(client.Object)
+	if !ok {
+		return fmt.Errorf("%s is not an object", gvk)
+	}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(object), currentObject); err == nil {
+		if err := r.upgradeManagedFields(ctx, currentObject); err != nil {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(object), err)
+	}
+
+	if err := r.apply(ctx, object, client.ForceOwnership); err != nil {
+		return fmt.Errorf("failed to apply %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(object), err)
+	}
+	return nil
+}
+
+// getMetadata returns the metadata of the existing object of the same name, or
+// nil if there is none. Objects not managed by the operator, such as those
+// created by tenants, may not be cached, so it is read from the API server.
+func (r *OrganizationReconciler) getMetadata(ctx context.Context, object client.Object) (*metav1.PartialObjectMetadata, error) { //nolint:lll
+	gvk, err := apiutil.GVKForObject(object, r.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	existing := &metav1.PartialObjectMetadata{}
+	existing.SetGroupVersionKind(gvk)
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	if err := reader.Get(ctx, client.ObjectKeyFromObject(object), existing); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get %s %s: %w", gvk.Kind, client.ObjectKeyFromObject(object), err)
+	}
+	return existing, nil
+}
+
+// setManagedLabels marks an object created in the organization namespace as
+// managed by the operator for the organization.
+func setManagedLabels(object *metav1.ObjectMeta, organization *securityv1beta1.Organization) {
+	if object.Labels == nil {
+		object.Labels = map[string]string{}
+	}
+	object.Labels[securityv1beta1.OrganizationLabel] = organization.Name
+	object.Labels[securityv1beta1.ManagedByLabel] = securityv1beta1.ManagedByValue
+}
+
+// deleteOwned deletes the object if it exists and is controlled by the
+// organization. Objects created by someone else are left alone.
+func (r *OrganizationReconciler) deleteOwned(ctx context.Context, organization *securityv1beta1.Organization, object client.Object) error { //nolint:lll
+	if err := r.Get(ctx, client.ObjectKeyFromObject(object), object); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(object, organization) {
+		return nil
+	}
+	if err := r.Delete(ctx, object); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete %T %s: %w", object, client.ObjectKeyFromObject(object), err)
+	}
+	return nil
+}
+
+// ownedComponent selects the objects of one kind and component the operator
+// manages for an organization.
+type ownedComponent struct {
+	list      client.ObjectList
+	component string
+}
+
+// ownedComponents returns the components the operator manages in the
+// organization namespace besides the ResourceQuota and the LimitRange, which
+// are looked up by name.
+func ownedComponents() []ownedComponent {
+	return []ownedComponent{
+		{&rbacv1.RoleBindingList{}, accessComponent},
+		{&rbacv1.RoleBindingList{}, serviceAccountComponent},
+		{&corev1.SecretList{}, serviceAccountComponent},
+		{&corev1.ServiceAccountList{}, serviceAccountComponent},
+		{&networkingv1.NetworkPolicyList{}, networkPolicyComponent},
+		{&corev1.SecretList{}, replicationComponent},
+		{&corev1.ConfigMapList{}, replicationComponent},
+	}
+}
+
+// listOwned returns the objects of the given component in the namespace that
+// are controlled by the organization. list selects the kind of the objects.
+func (r *OrganizationReconciler) listOwned(ctx context.Context, organization *securityv1beta1.Organization, list client.ObjectList, namespaceName, component string) ([]client.Object, error) { //nolint:lll
+	err := r.List(ctx, list, client.InNamespace(namespaceName), client.MatchingLabels{
+		securityv1beta1.OrganizationLabel: organization.Name,
+		securityv1beta1.ManagedByLabel:    securityv1beta1.ManagedByValue,
+		componentLabel:                    component,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %T: %w", list, err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	var objects []client.Object
+	for _, item := range items {
+		object, ok := item.(client.Object)
+		if ok && metav1.IsControlledBy(object, organization) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+// pruneOwned deletes the objects of the given component that the operator
+// manages for the organization in the namespace, except those named in keep.
+// list selects the kind of the objects.
+func (r *OrganizationReconciler) pruneOwned(ctx context.Context, organization *securityv1beta1.Organization, list client.ObjectList, namespaceName, component string, keep map[string]bool) error { //nolint:lll
+	objects, err := r.listOwned(ctx, organization, list, namespaceName, component)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if keep[object.GetName()] {
+			continue
+		}
+		if err := r.Delete(ctx, object); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %T %s: %w", object, client.ObjectKeyFromObject(object), err)
+		}
+	}
+	return nil
+}
+
+// releaseOwned removes the ownerReference of the organization from the
+// objects it controls in the namespace, so that the garbage collector keeps
+// them along with the namespace once the organization is gone. With retain,
+// they are released from the operator like the namespace.
+func (r *OrganizationReconciler) releaseOwned(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string, retain bool) error { //nolint:lll
+	var objects []client.Object
+	for _, c := range ownedComponents() {
+		owned, err := r.listOwned(ctx, organization, c.list, namespaceName, c.component)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, owned...)
+	}
+	for _, object := range []client.Object{
+		&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName, Namespace: namespaceName}},
+		&corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: limitRangeName, Namespace: namespaceName}},
+	} {
+		if err := r.Get(ctx, client.ObjectKeyFromObject(object), object); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get %T %s: %w", object, client.ObjectKeyFromObject(object), err)
+		}
+		if metav1.IsControlledBy(object, organization) {
+			objects = append(objects, object)
+		}
+	}
+
+	for _, object := range objects {
+		patch := client.MergeFrom(object.DeepCopyObject().(client.Object))
+		releaseMetadata(object, organization, retain)
+		if err := r.Patch(ctx, object, patch); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to release %T %s: %w", object, client.ObjectKeyFromObject(object), err)
+		}
+	}
+	return nil
+}
+
+// releaseMetadata removes the ownerReference of the organization from an
+// object it controls. With retain, the managed-by label and the operator's
+// annotations are removed as well, so that the object is no longer considered
+// managed.
+func releaseMetadata(object client.Object, organization *securityv1beta1.Organization, retain bool) {
+	object.SetOwnerReferences(slices.DeleteFunc(object.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+		return ref.UID == organization.UID
+	}))
+	if !retain {
+		return
+	}
+	delete(object.GetLabels(), securityv1beta1.ManagedByLabel)
+	annotations := object.GetAnnotations()
+	for key := range annotations {
+		if strings.HasPrefix(key, securityv1beta1.OperatorKeyPrefix) {
+			delete(annotations, key)
+		}
+	}
+}
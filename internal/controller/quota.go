@@ -0,0 +1,97 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+const (
+	// resourceQuotaName and limitRangeName are the names of the objects
+	// materialized from the organization spec in its namespace.
+	resourceQuotaName = "organization-quota"
+	limitRangeName    = "organization-limits"
+)
+
+// reconcileResourceQuota applies or deletes the ResourceQuota of the
+// organization namespace according to spec.namespace.resourceQuota, and
+// reports its hard limits and usage in the status. The hard limits are capped
+// by the ceiling inherited from the ancestors, and suspended organizations get
+// a quota of zero.
+func (r *OrganizationReconciler) reconcileResourceQuota(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string, ceiling corev1.ResourceList) error { //nolint:lll
+	quota := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceQuotaName,
+			Namespace: namespaceName,
+		},
+	}
+
+	spec := applyQuotaCeiling(organization.Spec.Namespace.ResourceQuota, ceiling)
+	if organization.Spec.Suspended {
+		spec = suspendedResourceQuota(spec)
+	}
+	if spec == nil {
+		organization.Status.ResourceQuota = nil
+		return r.deleteOwned(ctx, organization, quota)
+	}
+
+	setManagedLabels(&quota.ObjectMeta, organization)
+	quota.Spec = *spec.DeepCopy()
+	if err := r.applyOwned(ctx, organization, quota); err != nil {
+		return err
+	}
+
+	organization.Status.ResourceQuota = quota.Status.DeepCopy()
+	return nil
+}
+
+// reconcileLimitRange applies or deletes the LimitRange of the
+// organization namespace according to spec.namespace.limitRange.
+func (r *OrganizationReconciler) reconcileLimitRange(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	limitRange := &corev1.LimitRange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      limitRangeName,
+			Namespace: namespaceName,
+		},
+	}
+
+	if organization.Spec.Namespace.LimitRange == nil {
+		return r.deleteOwned(ctx, organization, limitRange)
+	}
+
+	setManagedLabels(&limitRange.ObjectMeta, organization)
+	limitRange.Spec = *organization.Spec.Namespace.LimitRange.DeepCopy()
+	return r.applyOwned(ctx, organization, limitRange)
+}
+
+// suspendedResourceQuota returns a quota that allows no pods and zero of every
+// resource limited by spec.
+func suspendedResourceQuota(spec *corev1.ResourceQuotaSpec) *corev1.ResourceQuotaSpec {
+	hard := corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")}
+	if spec != nil {
+		for name := range spec.Hard {
+			hard[name] = resource.MustParse("0")
+		}
+	}
+	return &corev1.ResourceQuotaSpec{Hard: hard}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+const replicationComponent = "replication"
+
+// ReplicationCacheOptions restricts the Secrets and ConfigMaps cached by the
+// manager to those of the replication namespace and the copies made by the
+// operator, rather than all of them in the cluster.
+func ReplicationCacheOptions(replicationNamespace string) map[client.Object]cache.ByObject {
+	managed := labels.SelectorFromSet(labels.Set{securityv1beta1.ManagedByLabel: securityv1beta1.ManagedByValue})
+	byObject := cache.ByObject{Label: managed}
+	if replicationNamespace != "" {
+		byObject = cache.ByObject{Namespaces: map[string]cache.Config{
+			replicationNamespace: {LabelSelector: labels.Everything()},
+			cache.AllNamespaces:  {LabelSelector: managed},
+		}}
+	}
+	return map[client.Object]cache.ByObject{
+		&corev1.Secret{}:    byObject,
+		&corev1.ConfigMap{}: byObject,
+	}
+}
+
+// reconcileReplication copies the Secrets and ConfigMaps of the replication
+// namespace annotated for replication into the organization namespace, and
+// deletes the copies of sources that were removed or no longer select the
+// namespace. Objects of the same name that are not copies are reported and
+// left alone.
+func (r *OrganizationReconciler) reconcileReplication(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	if r.ReplicationNamespace == "" || r.ReplicationNamespace == namespaceName {
+		return nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace); err != nil {
+		return fmt.Errorf("failed to get Namespace %s: %w", namespaceName, err)
+	}
+
+	var secrets corev1.SecretList
+	if err := r.List(ctx, &secrets, client.InNamespace(r.ReplicationNamespace)); err != nil {
+		return fmt.Errorf("failed to list Secrets to replicate: %w", err)
+	}
+	keepSecrets := map[string]bool{}
+	for _, source := range secrets.Items {
+		if !r.replicates(ctx, &source, namespace) {
+			continue
+		}
+		keepSecrets[source.Name] = true
+		replica := &corev1.Secret{
+			ObjectMeta: replicaMeta(organization, &source, namespaceName),
+			Type:       source.Type,
+			Data:       source.Data,
+		}
+		if err := r.applyReplica(ctx, organization, replica); err != nil {
+			return err
+		}
+	}
+
+	var configMaps corev1.ConfigMapList
+	if err := r.List(ctx, &configMaps, client.InNamespace(r.ReplicationNamespace)); err != nil {
+		return fmt.Errorf("failed to list ConfigMaps to replicate: %w", err)
+	}
+	keepConfigMaps := map[string]bool{}
+	for _, source := range configMaps.Items {
+		if !r.replicates(ctx, &source, namespace) {
+			continue
+		}
+		keepConfigMaps[source.Name] = true
+		replica := &corev1.ConfigMap{
+			ObjectMeta: replicaMeta(organization, &source, namespaceName),
+			Data:       source.Data,
+			BinaryData: source.BinaryData,
+		}
+		if err := r.applyReplica(ctx, organization, replica); err != nil {
+			return err
+		}
+	}
+
+	if err := r.pruneOwned(ctx, organization, &corev1.SecretList{}, namespaceName, replicationComponent, keepSecrets); err != nil { //nolint:lll
+		return err
+	}
+	return r.pruneOwned(ctx, organization, &corev1.ConfigMapList{}, namespaceName, replicationComponent, keepConfigMaps)
+}
+
+// replicates reports whether the source is annotated for replication into the
+// namespace. Sources with an invalid selector are not replicated anywhere.
+func (r *OrganizationReconciler) replicates(ctx context.Context, source client.Object, namespace *corev1.Namespace) bool { //nolint:lll
+	annotations := source.GetAnnotations()
+	if annotations[securityv1beta1.ReplicateAnnotation] != "true" {
+		return false
+	}
+	value, ok := annotations[securityv1beta1.ReplicateSelectorAnnotation]
+	if !ok {
+		return true
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Invalid replication selector",
+			"source", client.ObjectKeyFromObject(source), "selector", value)
+		return false
+	}
+	return selector.Matches(labels.Set(namespace.Labels))
+}
+
+// replicaMeta returns the metadata of the copy of source in the organization
+// namespace.
+func replicaMeta(organization *securityv1beta1.Organization, source client.Object, namespaceName string) metav1.ObjectMeta { //nolint:lll
+	objectMeta := metav1.ObjectMeta{
+		Name:      source.GetName(),
+		Namespace: namespaceName,
+		Annotations: map[string]string{
+			securityv1beta1.ReplicatedFromAnnotation: client.ObjectKeyFromObject(source).String(),
+		},
+	}
+	setManagedLabels(&objectMeta, organization)
+	objectMeta.Labels[componentLabel] = replicationComponent
+	return objectMeta
+}
+
+// applyReplica applies the copy of a source. An existing object of the same
+// name that is not a copy controlled by the organization is left alone and
+// reported in an event rather than failing the reconcile. Copies are fully
+// owned by the operator, so changes made to them by other field managers are
+// reverted.
+func (r *OrganizationReconciler) applyReplica(ctx context.Context, organization *securityv1beta1.Organization, replica client.Object) error { //nolint:lll
+	if err := ctrl.SetControllerReference(organization, replica, r.Scheme); err != nil {
+		return err
+	}
+
+	existing, err := r.getMetadata(ctx, replica)
+	if err != nil {
+		return err
+	}
+	if existing != nil && (existing.Annotations[securityv1beta1.ReplicatedFromAnnotation] == "" ||
+		!metav1.IsControlledBy(existing, organization)) {
+		log.FromContext(ctx).Info("Replica conflict", "replica", client.ObjectKeyFromObject(replica),
+			"error", "existing object is not a replica")
+		r.Recorder.Eventf(organization, replica, corev1.EventTypeWarning, securityv1beta1.ReasonReplicaConflict,
+			"Reconcile", "Not replicating %s %s, an object of the same name that is not a replica exists",
+			existing.Kind, client.ObjectKeyFromObject(replica))
+		return nil
+	}
+
+	if err := r.apply(ctx, replica, client.ForceOwnership); err != nil {
+		return fmt.Errorf("failed to apply %T %s: %w", replica, client.ObjectKeyFromObject(replica), err)
+	}
+	return nil
+}
+
+// replicationSourcePredicate passes events of objects in the replication
+// namespace that are, or were, annotated for replication.
+func (r *OrganizationReconciler) replicationSourcePredicate() predicate.Predicate {
+	isSource := func(object client.Object) bool {
+		return object != nil && r.ReplicationNamespace != "" && object.GetNamespace() == r.ReplicationNamespace &&
+			object.GetAnnotations()[securityv1beta1.ReplicateAnnotation] == "true"
+	}
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return isSource(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return isSource(e.ObjectOld) || isSource(e.ObjectNew) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return isSource(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return isSource(e.Object) },
+	}
+}
+
+// replicationRequests maps a change of a replication source to all
+// organizations, whose namespaces it may be copied into.
+func (r *OrganizationReconciler) replicationRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	var organizationList securityv1beta1.OrganizationList
+	if err := r.List(ctx, &organizationList); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list organizations to replicate into")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(organizationList.Items))
+	for _, organization := range organizationList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: organization.Name}})
+	}
+	return requests
+}
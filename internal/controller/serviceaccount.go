@@ -0,0 +1,153 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/naming"
+)
+
+const (
+	serviceAccountComponent   = "service-account"
+	serviceAccountTokenSuffix = "-token"
+)
+
+// reconcileServiceAccounts applies a ServiceAccount per spec.serviceAccounts
+// entry in the organization namespace, with a RoleBinding per ClusterRole and
+// a token Secret when requested, and deletes those of removed entries. While
+// the organization is suspended, only ClusterRoles listed in
+// ReadOnlyClusterRoles stay bound. The provisioned ServiceAccounts are
+// reported in the status.
+func (r *OrganizationReconciler) reconcileServiceAccounts(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) error { //nolint:lll
+	desiredServiceAccounts := map[string]bool{}
+	desiredRoleBindings := map[string]bool{}
+	desiredSecrets := map[string]bool{}
+	provisioned := make([]securityv1beta1.ServiceAccountStatus, 0, len(organization.Spec.ServiceAccounts))
+	defer func() {
+		organization.Status.ServiceAccounts = provisioned
+	}()
+
+	for _, spec := range organization.Spec.ServiceAccounts {
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: serviceAccountMeta(organization, spec.Name, namespaceName),
+		}
+		applied, err := r.applyServiceAccountObject(ctx, organization, serviceAccount)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			// Neither bind nor issue tokens for a ServiceAccount of someone else
+			continue
+		}
+		desiredServiceAccounts[serviceAccount.Name] = true
+		status := securityv1beta1.ServiceAccountStatus{Name: serviceAccount.Name}
+
+		for _, clusterRole := range spec.ClusterRoles {
+			if organization.Spec.Suspended && !slices.Contains(r.ReadOnlyClusterRoles, clusterRole) {
+				continue
+			}
+			roleBinding := &rbacv1.RoleBinding{
+				ObjectMeta: serviceAccountMeta(organization,
+					naming.ServiceAccountRoleBindingName(spec.Name, clusterRole), namespaceName),
+				RoleRef: rbacv1.RoleRef{
+					APIGroup: rbacv1.GroupName,
+					Kind:     "ClusterRole",
+					Name:     clusterRole,
+				},
+				Subjects: []rbacv1.Subject{{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      spec.Name,
+					Namespace: namespaceName,
+				}},
+			}
+			applied, err := r.applyServiceAccountObject(ctx, organization, roleBinding)
+			if err != nil {
+				return err
+			}
+			if applied {
+				desiredRoleBindings[roleBinding.Name] = true
+			}
+		}
+
+		if spec.Token {
+			secret := &corev1.Secret{
+				ObjectMeta: serviceAccountMeta(organization, spec.Name+serviceAccountTokenSuffix, namespaceName),
+				Type:       corev1.SecretTypeServiceAccountToken,
+			}
+			secret.Annotations = map[string]string{corev1.ServiceAccountNameKey: spec.Name}
+			applied, err := r.applyServiceAccountObject(ctx, organization, secret)
+			if err != nil {
+				return err
+			}
+			if applied {
+				desiredSecrets[secret.Name] = true
+				status.TokenSecret = secret.Name
+			}
+		}
+		provisioned = append(provisioned, status)
+	}
+
+	if err := r.pruneOwned(ctx, organization, &rbacv1.RoleBindingList{}, namespaceName, serviceAccountComponent, desiredRoleBindings); err != nil { //nolint:lll
+		return err
+	}
+	if err := r.pruneOwned(ctx, organization, &corev1.SecretList{}, namespaceName, serviceAccountComponent, desiredSecrets); err != nil { //nolint:lll
+		return err
+	}
+	return r.pruneOwned(ctx, organization, &corev1.ServiceAccountList{}, namespaceName, serviceAccountComponent, desiredServiceAccounts) //nolint:lll
+}
+
+// applyServiceAccountObject applies an object provisioned for
+// spec.serviceAccounts and reports whether it was applied. An existing object
+// of the same name that is not controlled by the organization, such as one
+// created by a tenant, is left alone and reported in an event, so that it is
+// neither taken over nor pruned later.
+func (r *OrganizationReconciler) applyServiceAccountObject(ctx context.Context, organization *securityv1beta1.Organization, object client.Object) (bool, error) { //nolint:lll
+	existing, err := r.getMetadata(ctx, object)
+	if err != nil {
+		return false, err
+	}
+	if existing != nil && !metav1.IsControlledBy(existing, organization) {
+		log.FromContext(ctx).Info("ServiceAccount conflict", "kind", existing.Kind,
+			"object", client.ObjectKeyFromObject(object))
+		r.Recorder.Eventf(organization, object, corev1.EventTypeWarning, securityv1beta1.ReasonServiceAccountConflict,
+			"Reconcile", "Not provisioning %s %s, an object of the same name not controlled by the organization exists",
+			existing.Kind, client.ObjectKeyFromObject(object))
+		return false, nil
+	}
+	return true, r.applyOwned(ctx, organization, object)
+}
+
+// serviceAccountMeta returns the metadata of an object provisioned for
+// spec.serviceAccounts.
+func serviceAccountMeta(organization *securityv1beta1.Organization, name, namespaceName string) metav1.ObjectMeta {
+	objectMeta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespaceName,
+	}
+	setManagedLabels(&objectMeta, organization)
+	objectMeta.Labels[componentLabel] = serviceAccountComponent
+	return objectMeta
+}
@@ -19,17 +19,40 @@ import (
 
 	ginkgo "github.com/onsi/ginkgo/v2"
 	gomega "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	clientgoapplyconfigurations "k8s.io/client-go/applyconfigurations"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/structured-merge-diff/v6/typed"
 
-	securityv1alpha1 "github.com/giantswarm/organization-operator/api/v1alpha1"
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
 )
 
 var k8sClient client.Client
 
+// statuslessTypeConverter drops the empty status the fake client sets on
+// applied objects, which kinds without a status, such as NetworkPolicy, do not
+// declare in their schema.
+type statuslessTypeConverter struct {
+	managedfields.TypeConverter
+}
+
+func (c statuslessTypeConverter) ObjectToTyped(object runtime.Object, opts ...typed.ValidationOptions) (*typed.TypedValue, error) { //nolint:lll
+	if u, ok := object.(*unstructured.Unstructured); ok {
+		if status, found := u.Object["status"]; found && status == nil {
+			u = u.DeepCopy()
+			delete(u.Object, "status")
+			object = u
+		}
+	}
+	return c.TypeConverter.ObjectToTyped(object, opts...)
+}
+
 func TestAPIs(t *testing.T) {
 	gomega.RegisterFailHandler(ginkgo.Fail)
 	ginkgo.RunSpecs(t, "Controller Suite")
@@ -40,12 +63,17 @@ var _ = ginkgo.BeforeSuite(func() {
 
 	ginkgo.By("bootstrapping test environment")
 
-	err := securityv1alpha1.AddToScheme(scheme.Scheme)
+	err := securityv1beta1.AddToScheme(scheme.Scheme)
 	gomega.Expect(err).NotTo(gomega.HaveOccurred())
 
 	k8sClient = fake.NewClientBuilder().
 		WithScheme(scheme.Scheme).
-		WithStatusSubresource(&securityv1alpha1.Organization{}).
+		WithStatusSubresource(&securityv1beta1.Organization{}).
+		WithTypeConverters(
+			statuslessTypeConverter{clientgoapplyconfigurations.NewTypeConverter(scheme.Scheme)},
+			managedfields.NewDeducedTypeConverter(),
+		).
+		WithReturnManagedFields().
 		Build()
 	gomega.Expect(k8sClient).NotTo(gomega.BeNil())
 })
@@ -0,0 +1,112 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/protection"
+)
+
+// teardownRequeueAfter is how often the progress of a teardown is checked.
+const teardownRequeueAfter = 10 * time.Second
+
+// DefaultTeardownKinds are the kinds deleted before the organization namespace
+// when no kinds are configured: Cluster API clusters and Giant Swarm Apps.
+var DefaultTeardownKinds = []schema.GroupVersionKind{
+	protection.ClusterKind,
+	{Group: "application.giantswarm.io", Version: "v1alpha1", Kind: "App"},
+}
+
+// deleteTeardownResources deletes the objects of the teardown kinds in the
+// namespace and returns the ones still present, as "Kind/name" strings. Kinds
+// whose CRD is not installed are skipped.
+func (r *OrganizationReconciler) deleteTeardownResources(ctx context.Context, namespaceName string) ([]string, error) {
+	var remaining []string
+	for _, gvk := range r.TeardownKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, list, client.InNamespace(namespaceName)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s in namespace %s: %w", gvk.Kind, namespaceName, err)
+		}
+		for i := range list.Items {
+			item := &list.Items[i]
+			if item.GetDeletionTimestamp() == nil {
+				if err := r.Delete(ctx, item); client.IgnoreNotFound(err) != nil {
+					return nil, fmt.Errorf("failed to delete %s %s/%s: %w", gvk.Kind, namespaceName, item.GetName(), err)
+				}
+			}
+			remaining = append(remaining, fmt.Sprintf("%s/%s", gvk.Kind, item.GetName()))
+		}
+	}
+	return remaining, nil
+}
+
+// setTeardown records the teardown stage and the resources it waits for in
+// the status, and reports whether the stage changed.
+func setTeardown(organization *securityv1beta1.Organization, stage securityv1beta1.TeardownStage, remaining []string) bool { //nolint:lll
+	changed := organization.Status.Teardown == nil || organization.Status.Teardown.Stage != stage
+	organization.Status.Teardown = &securityv1beta1.TeardownStatus{
+		Stage:              stage,
+		RemainingResources: remaining,
+	}
+	return changed
+}
+
+// checkDeletionTimeout raises the DeletionStuck condition, a Warning event and
+// the organization_deletion_stuck metric once the deletion of the organization
+// takes longer than the deletion timeout. A zero timeout disables the check.
+func (r *OrganizationReconciler) checkDeletionTimeout(organization *securityv1beta1.Organization) {
+	if r.DeletionTimeout <= 0 || organization.GetDeletionTimestamp() == nil {
+		return
+	}
+	if time.Since(organization.GetDeletionTimestamp().Time) < r.DeletionTimeout {
+		setCondition(organization, securityv1beta1.ConditionDeletionStuck, metav1.ConditionFalse,
+			securityv1beta1.ReasonDeletionInProgress,
+			fmt.Sprintf("Deletion is within the timeout of %s", r.DeletionTimeout))
+		return
+	}
+
+	message := fmt.Sprintf("Deletion exceeded the timeout of %s", r.DeletionTimeout)
+	if teardown := organization.Status.Teardown; teardown != nil {
+		message = fmt.Sprintf("%s in stage %s", message, teardown.Stage)
+		if len(teardown.RemainingResources) > 0 {
+			message = fmt.Sprintf("%s, waiting for %s", message, strings.Join(teardown.RemainingResources, ", "))
+		}
+	}
+	if !meta.IsStatusConditionTrue(organization.Status.Conditions, securityv1beta1.ConditionDeletionStuck) {
+		r.Recorder.Eventf(organization, nil, corev1.EventTypeWarning, securityv1beta1.ReasonDeletionTimeoutExceeded,
+			"Delete", "%s", message)
+	}
+	setCondition(organization, securityv1beta1.ConditionDeletionStuck, metav1.ConditionTrue,
+		securityv1beta1.ReasonDeletionTimeoutExceeded, message)
+	organizationDeletionStuck.WithLabelValues(organization.Name).Set(1)
+}
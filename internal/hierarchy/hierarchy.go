@@ -0,0 +1,99 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hierarchy walks the parent/child relationships between
+// organizations.
+package hierarchy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// ErrCycle is returned when following the parents of an organization leads
+// back to an organization already visited.
+var ErrCycle = errors.New("organization hierarchy contains a cycle")
+
+// Ancestors returns the ancestors of the organization, nearest first. The
+// error wraps the NotFound error of a missing parent, or ErrCycle.
+func Ancestors(ctx context.Context, c client.Reader, organization *securityv1beta1.Organization) ([]securityv1beta1.Organization, error) { //nolint:lll
+	var ancestors []securityv1beta1.Organization
+	visited := map[string]bool{organization.Name: true}
+	for parent := organization.Spec.Parent; parent != ""; {
+		if visited[parent] {
+			return nil, fmt.Errorf("%w: %s is its own ancestor", ErrCycle, parent)
+		}
+		visited[parent] = true
+
+		ancestor := securityv1beta1.Organization{}
+		if err := c.Get(ctx, client.ObjectKey{Name: parent}, &ancestor); err != nil {
+			return nil, fmt.Errorf("failed to get parent organization %s: %w", parent, err)
+		}
+		ancestors = append(ancestors, ancestor)
+		parent = ancestor.Spec.Parent
+	}
+	return ancestors, nil
+}
+
+// Children returns the names of the organizations whose parent is name, in
+// alphabetical order.
+func Children(ctx context.Context, c client.Reader, name string) ([]string, error) {
+	organizations := &securityv1beta1.OrganizationList{}
+	if err := c.List(ctx, organizations); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	var children []string
+	for _, organization := range organizations.Items {
+		if organization.Spec.Parent == name {
+			children = append(children, organization.Name)
+		}
+	}
+	sort.Strings(children)
+	return children, nil
+}
+
+// Descendants returns the names of the organizations below name in the
+// hierarchy, in no particular order.
+func Descendants(ctx context.Context, c client.Reader, name string) ([]string, error) {
+	organizations := &securityv1beta1.OrganizationList{}
+	if err := c.List(ctx, organizations); err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+	children := map[string][]string{}
+	for _, organization := range organizations.Items {
+		if organization.Spec.Parent != "" {
+			children[organization.Spec.Parent] = append(children[organization.Spec.Parent], organization.Name)
+		}
+	}
+
+	var descendants []string
+	visited := map[string]bool{name: true}
+	for queue := children[name]; len(queue) > 0; queue = queue[1:] {
+		if visited[queue[0]] {
+			continue
+		}
+		visited[queue[0]] = true
+		descendants = append(descendants, queue[0])
+		queue = append(queue, children[queue[0]]...)
+	}
+	return descendants, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package naming derives the names of the namespace of an organization and of
+// the objects created in it.
+package naming
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// serviceAccountRoleBindingPrefix prefixes the names of the RoleBindings of
+// the ServiceAccounts declared by an organization.
+const serviceAccountRoleBindingPrefix = "organization-service-account-"
+
+// DefaultTemplate is the namespace naming template used when none is
+// configured.
+const DefaultTemplate = "org-{{ .Name }}"
+
+// Template renders the namespace name of an organization. The Organization is
+// passed as data, so templates refer to its name as {{ .Name }}. The nil
+// Template renders DefaultTemplate.
+type Template struct {
+	text string
+	tmpl *template.Template
+}
+
+var defaultTemplate = mustParse(DefaultTemplate)
+
+// Parse parses a namespace naming template and checks that it renders a valid
+// namespace name.
+func Parse(text string) (*Template, error) {
+	tmpl, err := template.New("namespace").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid namespace template %q: %w", text, err)
+	}
+
+	t := &Template{text: text, tmpl: tmpl}
+	example := &securityv1beta1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "example"}}
+	name, err := t.render(example)
+	if err != nil {
+		return nil, err
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid namespace template %q: renders %q: %s", text, name, strings.Join(errs, ", "))
+	}
+	return t, nil
+}
+
+func mustParse(text string) *Template {
+	t, err := Parse(text)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// String returns the text of the template.
+func (t *Template) String() string {
+	if t == nil {
+		return DefaultTemplate
+	}
+	return t.text
+}
+
+// NamespaceName renders the namespace name of the organization from the
+// template, ignoring the namespace recorded in its status.
+func (t *Template) NamespaceName(organization *securityv1beta1.Organization) (string, error) {
+	if t == nil {
+		t = defaultTemplate
+	}
+	return t.render(organization)
+}
+
+func (t *Template) render(organization *securityv1beta1.Organization) (string, error) {
+	var name strings.Builder
+	if err := t.tmpl.Execute(&name, organization); err != nil {
+		return "", fmt.Errorf("failed to render namespace template %q: %w", t.text, err)
+	}
+	return name.String(), nil
+}
+
+// Resolve returns the namespace of the organization: the one recorded in its
+// status once the namespace has been created, so that changing the template
+// never orphans existing organizations, or the rendered one otherwise.
+func (t *Template) Resolve(organization *securityv1beta1.Organization) (string, error) {
+	if organization.Status.Namespace != "" {
+		return organization.Status.Namespace, nil
+	}
+	return t.NamespaceName(organization)
+}
+
+// ServiceAccountRoleBindingName returns the name of the RoleBinding granting a
+// ClusterRole to a ServiceAccount declared by an organization. ServiceAccount
+// names cannot contain dots, so the first dot separates the ServiceAccount
+// from the ClusterRole and no two pairs share a name.
+func ServiceAccountRoleBindingName(serviceAccount, clusterRole string) string {
+	return serviceAccountRoleBindingPrefix + serviceAccount + "." + clusterRole
+}
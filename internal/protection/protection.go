@@ -0,0 +1,101 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package protection finds the resources that block the deletion of an
+// organization namespace.
+package protection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+)
+
+// ClusterKind is the kind of Cluster API clusters. Clusters are handled as
+// unstructured objects, so that Cluster API is not a dependency of the
+// operator.
+var ClusterKind = schema.GroupVersionKind{Group: "cluster.x-k8s.io", Version: "v1beta1", Kind: "Cluster"}
+
+// DefaultKinds are the kinds protected when no kinds are configured:
+// Cluster API clusters.
+var DefaultKinds = []schema.GroupVersionKind{ClusterKind}
+
+// Checker lists the protected objects in an organization namespace.
+type Checker struct {
+	Client client.Reader
+	Kinds  []schema.GroupVersionKind
+}
+
+// IsOverridden reports whether the organization carries the annotation that
+// allows deleting it regardless of protected resources.
+func IsOverridden(organization *securityv1beta1.Organization) bool {
+	return organization.GetAnnotations()[securityv1beta1.AllowDeletionAnnotation] == "true"
+}
+
+// BlockingResources returns the protected objects found in the namespace, as
+// "Kind/name" strings. Kinds whose CRD is not installed are skipped.
+func (c *Checker) BlockingResources(ctx context.Context, namespace string) ([]string, error) {
+	var blocking []string
+	for _, gvk := range c.Kinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.Client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list %s in namespace %s: %w", gvk.Kind, namespace, err)
+		}
+		for _, item := range list.Items {
+			blocking = append(blocking, fmt.Sprintf("%s/%s", gvk.Kind, item.GetName()))
+		}
+	}
+	return blocking, nil
+}
+
+// ParseKinds parses kinds given as "Kind.version.group", e.g.
+// "Cluster.v1beta1.cluster.x-k8s.io".
+func ParseKinds(values []string) ([]schema.GroupVersionKind, error) {
+	kinds := make([]schema.GroupVersionKind, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		gvk, _ := schema.ParseKindArg(value)
+		if gvk == nil || gvk.Kind == "" || gvk.Version == "" {
+			return nil, fmt.Errorf("invalid kind %q, expected Kind.version.group", value)
+		}
+		kinds = append(kinds, *gvk)
+	}
+	return kinds, nil
+}
+
+// FormatKinds formats kinds as "Kind.version.group", the inverse of
+// ParseKinds.
+func FormatKinds(kinds []schema.GroupVersionKind) []string {
+	values := make([]string, 0, len(kinds))
+	for _, gvk := range kinds {
+		values = append(values, gvk.Kind+"."+gvk.Version+"."+gvk.Group)
+	}
+	return values
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	securityv1alpha1 "github.com/giantswarm/organization-operator/api/v1alpha1"
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/naming"
+)
+
+// The envtest specs send requests through a kube-apiserver to the webhook
+// registered with a manager, so they need the binaries installed by
+// setup-envtest and are skipped when KUBEBUILDER_ASSETS is unset.
+var _ = ginkgo.Describe("Organization webhook with envtest", ginkgo.Ordered, func() {
+	var (
+		ctx       context.Context
+		cancel    context.CancelFunc
+		testEnv   *envtest.Environment
+		apiClient client.Client
+	)
+
+	ginkgo.BeforeAll(func() {
+		if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+			ginkgo.Skip("KUBEBUILDER_ASSETS is not set")
+		}
+		ctx, cancel = context.WithCancel(context.Background())
+
+		testScheme := runtime.NewScheme()
+		gomega.Expect(clientgoscheme.AddToScheme(testScheme)).To(gomega.Succeed())
+		gomega.Expect(securityv1alpha1.AddToScheme(testScheme)).To(gomega.Succeed())
+		gomega.Expect(securityv1beta1.AddToScheme(testScheme)).To(gomega.Succeed())
+
+		testEnv = &envtest.Environment{
+			CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "config", "crd", "bases")},
+			ErrorIfCRDPathMissing: true,
+			WebhookInstallOptions: envtest.WebhookInstallOptions{
+				ValidatingWebhooks: []*admissionregistrationv1.ValidatingWebhookConfiguration{
+					validatingWebhookConfiguration(),
+				},
+			},
+		}
+		cfg, err := testEnv.Start()
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		apiClient, err = client.New(cfg, client.Options{Scheme: testScheme})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		template, err := naming.Parse(`{{ with index .Labels "tier" }}{{ . }}-{{ end }}{{ .Name }}`)
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+		webhookInstallOptions := &testEnv.WebhookInstallOptions
+		mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+			Scheme: testScheme,
+			WebhookServer: webhook.NewServer(webhook.Options{
+				Host:    webhookInstallOptions.LocalServingHost,
+				Port:    webhookInstallOptions.LocalServingPort,
+				CertDir: webhookInstallOptions.LocalServingCertDir,
+			}),
+			Metrics: metricsserver.Options{BindAddress: "0"},
+		})
+		gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		gomega.Expect(SetupOrganizationConversionWithManager(mgr)).To(gomega.Succeed())
+		gomega.Expect(SetupOrganizationWebhookWithManager(mgr, Options{NamespaceTemplate: template})).To(gomega.Succeed())
+
+		go func() {
+			defer ginkgo.GinkgoRecover()
+			gomega.Expect(mgr.Start(ctx)).To(gomega.Succeed())
+		}()
+
+		address := fmt.Sprintf("%s:%d", webhookInstallOptions.LocalServingHost, webhookInstallOptions.LocalServingPort)
+		gomega.Eventually(func() error {
+			conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", address,
+				&tls.Config{InsecureSkipVerify: true}) //nolint:gosec
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		}).Should(gomega.Succeed())
+	})
+
+	ginkgo.AfterAll(func() {
+		if testEnv == nil {
+			return
+		}
+		cancel()
+		gomega.Expect(testEnv.Stop()).To(gomega.Succeed())
+	})
+
+	ginkgo.It("Should reject an Organization producing an invalid namespace", func() {
+		org := &securityv1beta1.Organization{ObjectMeta: metav1.ObjectMeta{Name: "invalid.name"}}
+		err := apiClient.Create(ctx, org)
+		gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+	})
+
+	ginkgo.It("Should reject changes to the fields the namespace name is rendered from", func() {
+		org := &securityv1beta1.Organization{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "envtest",
+				Labels: map[string]string{"tier": "gold"},
+			},
+		}
+		gomega.Expect(apiClient.Create(ctx, org)).To(gomega.Succeed())
+
+		org.Labels["tier"] = "silver"
+		err := apiClient.Update(ctx, org)
+		gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+		org.Labels["tier"] = "gold"
+		org.Spec.Namespace.Labels = map[string]string{"team": "platform"}
+		gomega.Expect(apiClient.Update(ctx, org)).To(gomega.Succeed())
+	})
+})
+
+// validatingWebhookConfiguration mirrors the ValidatingWebhookConfiguration
+// of the chart. envtest points its client config at the local webhook server.
+func validatingWebhookConfiguration() *admissionregistrationv1.ValidatingWebhookConfiguration {
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "organization-operator",
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+			Name:                    "vorganization-v1beta1.security.giantswarm.io",
+			AdmissionReviewVersions: []string{"v1"},
+			ClientConfig: admissionregistrationv1.WebhookClientConfig{
+				Service: &admissionregistrationv1.ServiceReference{
+					Name:      "organization-operator",
+					Namespace: "default",
+					Path:      ptr.To("/validate-security-giantswarm-io-v1beta1-organization"),
+				},
+			},
+			FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+			SideEffects:   ptr.To(admissionregistrationv1.SideEffectClassNone),
+			Rules: []admissionregistrationv1.RuleWithOperations{{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+					admissionregistrationv1.Update,
+					admissionregistrationv1.Delete,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{securityv1beta1.GroupVersion.Group},
+					APIVersions: []string{securityv1beta1.GroupVersion.Version},
+					Resources:   []string{"organizations"},
+				},
+			}},
+		}},
+	}
+}
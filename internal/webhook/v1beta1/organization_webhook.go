@@ -0,0 +1,456 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 contains the admission webhooks for the security v1beta1 API group.
+package v1beta1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/validation/path"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/hierarchy"
+	"github.com/giantswarm/organization-operator/internal/naming"
+	"github.com/giantswarm/organization-operator/internal/protection"
+)
+
+var organizationlog = logf.Log.WithName("organization-resource")
+
+// DefaultAllowedClusterRoles are the ClusterRoles spec.access may bind when no
+// ClusterRoles are configured.
+var DefaultAllowedClusterRoles = []string{"view", "edit", "admin"}
+
+// podSecurityLevelRanks orders the Pod Security Standards levels from the least
+// to the most restrictive.
+var podSecurityLevelRanks = map[securityv1beta1.PodSecurityLevel]int{
+	securityv1beta1.PodSecurityLevelPrivileged: 0,
+	securityv1beta1.PodSecurityLevelBaseline:   1,
+	securityv1beta1.PodSecurityLevelRestricted: 2,
+}
+
+// ParsePodSecurityLevel parses a Pod Security Standards level.
+func ParsePodSecurityLevel(value string) (securityv1beta1.PodSecurityLevel, error) {
+	level := securityv1beta1.PodSecurityLevel(value)
+	if _, ok := podSecurityLevelRanks[level]; !ok {
+		return "", fmt.Errorf("invalid pod security level %q, must be one of privileged, baseline or restricted", value)
+	}
+	return level, nil
+}
+
+// Options configure the Organization webhook.
+type Options struct {
+	// ProtectedKinds are the kinds whose objects in the namespace of an
+	// organization deny its deletion.
+	ProtectedKinds []schema.GroupVersionKind
+	// MinimumPodSecurityLevel is the least restrictive pod security level
+	// organizations may enforce.
+	MinimumPodSecurityLevel securityv1beta1.PodSecurityLevel
+	// NamespaceTemplate renders the namespace name of new organizations.
+	NamespaceTemplate *naming.Template
+	// AllowedClusterRoles are the ClusterRoles organizations may bind.
+	AllowedClusterRoles []string
+}
+
+// SetupOrganizationConversionWithManager registers the conversion webhook for
+// Organization in the manager. The CRD always converts through it, so it is
+// served whether or not the validating webhook is enabled.
+func SetupOrganizationConversionWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &securityv1beta1.Organization{}).Complete()
+}
+
+// SetupOrganizationWebhookWithManager registers the webhook for Organization in the manager.
+func SetupOrganizationWebhookWithManager(mgr ctrl.Manager, options Options) error {
+	return ctrl.NewWebhookManagedBy(mgr, &securityv1beta1.Organization{}).
+		WithValidator(&OrganizationCustomValidator{
+			Client:                  mgr.GetClient(),
+			ProtectedKinds:          options.ProtectedKinds,
+			MinimumPodSecurityLevel: options.MinimumPodSecurityLevel,
+			NamespaceTemplate:       options.NamespaceTemplate,
+			AllowedClusterRoles:     options.AllowedClusterRoles,
+		}).
+		Complete()
+}
+
+//nolint:lll
+// +kubebuilder:webhook:path=/validate-security-giantswarm-io-v1beta1-organization,mutating=false,failurePolicy=fail,sideEffects=None,groups=security.giantswarm.io,resources=organizations,verbs=create;update;delete,versions=v1beta1,name=vorganization-v1beta1.security.giantswarm.io,admissionReviewVersions=v1
+
+// OrganizationCustomValidator validates Organization resources on create,
+// update and delete.
+type OrganizationCustomValidator struct {
+	Client         client.Client
+	ProtectedKinds []schema.GroupVersionKind
+	// MinimumPodSecurityLevel is the least restrictive level organizations
+	// may enforce. Empty allows any level.
+	MinimumPodSecurityLevel securityv1beta1.PodSecurityLevel
+	// NamespaceTemplate renders the namespace name of new organizations. The
+	// nil template renders naming.DefaultTemplate.
+	NamespaceTemplate *naming.Template
+	// AllowedClusterRoles are the ClusterRoles organizations may bind. Empty
+	// allows any ClusterRole.
+	AllowedClusterRoles []string
+}
+
+var _ admission.Validator[*securityv1beta1.Organization] = &OrganizationCustomValidator{}
+
+// ValidateCreate rejects organizations whose namespace would be invalid or
+// would collide with an existing namespace the organization does not adopt.
+func (v *OrganizationCustomValidator) ValidateCreate(ctx context.Context, organization *securityv1beta1.Organization) (admission.Warnings, error) { //nolint:lll
+	organizationlog.Info("Validation for Organization upon creation", "name", organization.GetName())
+
+	namespaceName, err := v.NamespaceTemplate.NamespaceName(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs := validateNamespaceName(organization, namespaceName)
+	allErrs = append(allErrs, validateSpec(organization)...)
+	allErrs = append(allErrs, v.validatePodSecurityMinimum(nil, organization)...)
+	allErrs = append(allErrs, v.validateClusterRoles(nil, organization)...)
+	errs, err := v.validateParent(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, errs...)
+	if len(allErrs) == 0 {
+		errs, err := v.validateNamespaceCollision(ctx, organization, namespaceName)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return nil, toInvalidError(organization, allErrs)
+}
+
+// ValidateUpdate validates the spec and rejects changes to the fields the
+// namespace name is rendered from, downgrades of the enforced pod security
+// level below the minimum, newly bound ClusterRoles that are not allowed and
+// parents that would create a cycle.
+func (v *OrganizationCustomValidator) ValidateUpdate(ctx context.Context, oldOrganization, organization *securityv1beta1.Organization) (admission.Warnings, error) { //nolint:lll
+	organizationlog.Info("Validation for Organization upon update", "name", organization.GetName())
+
+	allErrs, err := v.validateNamespaceNameUnchanged(oldOrganization, organization)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, validateSpec(organization)...)
+	allErrs = append(allErrs, v.validatePodSecurityMinimum(oldOrganization, organization)...)
+	allErrs = append(allErrs, v.validateClusterRoles(oldOrganization, organization)...)
+	errs, err := v.validateParent(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	allErrs = append(allErrs, errs...)
+
+	return nil, toInvalidError(organization, allErrs)
+}
+
+// ValidateDelete denies deleting an organization that still has children, or
+// whose namespace still contains protected resources, unless the override
+// annotation is set or the deletion policy keeps the namespace.
+func (v *OrganizationCustomValidator) ValidateDelete(ctx context.Context, organization *securityv1beta1.Organization) (admission.Warnings, error) { //nolint:lll
+	organizationlog.Info("Validation for Organization upon deletion", "name", organization.GetName())
+
+	children, err := hierarchy.Children(ctx, v.Client, organization.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(children) > 0 {
+		return nil, apierrors.NewForbidden(securityv1beta1.GroupVersion.WithResource("organizations").GroupResource(),
+			organization.Name, fmt.Errorf("organization still has children %s", strings.Join(children, ", ")))
+	}
+
+	if protection.IsOverridden(organization) ||
+		organization.GetDeletionPolicy() != securityv1beta1.DeletionPolicyDelete {
+		return nil, nil
+	}
+
+	namespaceName, err := v.NamespaceTemplate.Resolve(organization)
+	if err != nil {
+		return nil, err
+	}
+	checker := &protection.Checker{Client: v.Client, Kinds: v.ProtectedKinds}
+	blocking, err := checker.BlockingResources(ctx, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocking) > 0 {
+		return nil, apierrors.NewForbidden(securityv1beta1.GroupVersion.WithResource("organizations").GroupResource(),
+			organization.Name, fmt.Errorf("namespace %s still contains %s, set annotation %s=true to delete anyway",
+				namespaceName, strings.Join(blocking, ", "), securityv1beta1.AllowDeletionAnnotation))
+	}
+
+	return nil, nil
+}
+
+// validateNamespaceName checks that the namespace rendered for the
+// organization is a valid namespace name.
+func validateNamespaceName(organization *securityv1beta1.Organization, namespaceName string) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, msg := range validation.IsDNS1123Label(namespaceName) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), organization.Name,
+			fmt.Sprintf("namespace %q is not a valid namespace name: %s", namespaceName, msg)))
+	}
+	return allErrs
+}
+
+// validateNamespaceNameUnchanged makes the fields the namespace template
+// renders the namespace name from immutable: changing them would move the
+// organization to another namespace, which only changing the template and
+// requesting a migration may do.
+func (v *OrganizationCustomValidator) validateNamespaceNameUnchanged(oldOrganization, organization *securityv1beta1.Organization) (field.ErrorList, error) { //nolint:lll
+	oldNamespaceName, err := v.NamespaceTemplate.NamespaceName(oldOrganization)
+	if err != nil {
+		return nil, err
+	}
+	namespaceName, err := v.NamespaceTemplate.NamespaceName(organization)
+	if err != nil {
+		return nil, err
+	}
+	if namespaceName == oldNamespaceName {
+		return nil, nil
+	}
+
+	fldPath := field.NewPath("spec")
+	if apiequality.Semantic.DeepEqual(oldOrganization.Spec, organization.Spec) {
+		fldPath = field.NewPath("metadata")
+	}
+	return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf(
+		"fields rendered into the namespace name by the template %q are immutable, the namespace would change from %s to %s",
+		v.NamespaceTemplate, oldNamespaceName, namespaceName))}, nil
+}
+
+// validateSpec checks the namespace labels and annotations, the access
+// bindings and the ServiceAccounts requested in the spec.
+func validateSpec(organization *securityv1beta1.Organization) field.ErrorList {
+	specPath := field.NewPath("spec")
+	labelsPath := specPath.Child("namespace", "labels")
+	annotationsPath := specPath.Child("namespace", "annotations")
+
+	allErrs := metav1validation.ValidateLabels(organization.Spec.Namespace.Labels, labelsPath)
+	for key := range organization.Spec.Namespace.Labels {
+		if securityv1beta1.IsReservedNamespaceKey(key) {
+			allErrs = append(allErrs, field.Forbidden(labelsPath.Key(key), "label is managed by organization-operator"))
+		}
+		if strings.HasPrefix(key, securityv1beta1.PodSecurityLabelPrefix) {
+			allErrs = append(allErrs, field.Forbidden(labelsPath.Key(key), "use spec.namespace.podSecurity instead"))
+		}
+	}
+	for key := range organization.Spec.Namespace.Annotations {
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(annotationsPath, key, msg))
+		}
+		if securityv1beta1.IsReservedNamespaceKey(key) {
+			allErrs = append(allErrs, field.Forbidden(annotationsPath.Key(key),
+				"annotation is managed by organization-operator"))
+		}
+	}
+	allErrs = append(allErrs, validateAccess(organization.Spec.Access, specPath.Child("access"))...)
+	allErrs = append(allErrs, validateServiceAccounts(organization.Spec.ServiceAccounts,
+		specPath.Child("serviceAccounts"))...)
+	return allErrs
+}
+
+// validateParent rejects parents that would make the organization its own
+// ancestor. Parents that do not exist yet are accepted; the controller reports
+// them until they are created.
+func (v *OrganizationCustomValidator) validateParent(ctx context.Context, organization *securityv1beta1.Organization) (field.ErrorList, error) { //nolint:lll
+	if organization.Spec.Parent == "" {
+		return nil, nil
+	}
+	_, err := hierarchy.Ancestors(ctx, v.Client, organization)
+	switch {
+	case errors.Is(err, hierarchy.ErrCycle):
+		return field.ErrorList{field.Invalid(field.NewPath("spec", "parent"), organization.Spec.Parent, err.Error())}, nil
+	case err != nil && !apierrors.IsNotFound(err):
+		return nil, err
+	}
+	return nil, nil
+}
+
+// validatePodSecurityMinimum rejects enforcing a pod security level below the
+// minimum. An unset level leaves the namespace at the cluster default and is
+// ranked as privileged. An unchanged level is accepted on update, so that
+// organizations created before the minimum was raised can still be edited.
+func (v *OrganizationCustomValidator) validatePodSecurityMinimum(oldOrganization, organization *securityv1beta1.Organization) field.ErrorList { //nolint:lll
+	if v.MinimumPodSecurityLevel == "" {
+		return nil
+	}
+	level := enforcedPodSecurityLevel(organization)
+	if oldOrganization != nil && enforcedPodSecurityLevel(oldOrganization) == level {
+		return nil
+	}
+	rank := podSecurityLevelRanks[securityv1beta1.PodSecurityLevelPrivileged]
+	if level != "" {
+		rank = podSecurityLevelRanks[level]
+	}
+	if rank >= podSecurityLevelRanks[v.MinimumPodSecurityLevel] {
+		return nil
+	}
+	enforcePath := field.NewPath("spec", "namespace", "podSecurity", "enforce")
+	if level == "" {
+		return field.ErrorList{field.Required(enforcePath,
+			fmt.Sprintf("a level of at least %s must be enforced", v.MinimumPodSecurityLevel))}
+	}
+	return field.ErrorList{field.Forbidden(enforcePath,
+		fmt.Sprintf("level %s is below the minimum %s", level, v.MinimumPodSecurityLevel))}
+}
+
+func enforcedPodSecurityLevel(organization *securityv1beta1.Organization) securityv1beta1.PodSecurityLevel {
+	if organization.Spec.Namespace.PodSecurity == nil {
+		return ""
+	}
+	return organization.Spec.Namespace.PodSecurity.Enforce
+}
+
+// validateClusterRoles rejects binding ClusterRoles that are not allowed,
+// through spec.access or spec.serviceAccounts. A ClusterRole the old
+// organization already binds is accepted on update, so that organizations
+// created before the allowed ClusterRoles were narrowed can still be edited.
+func (v *OrganizationCustomValidator) validateClusterRoles(oldOrganization, organization *securityv1beta1.Organization) field.ErrorList { //nolint:lll
+	if len(v.AllowedClusterRoles) == 0 {
+		return nil
+	}
+	bound := map[string]bool{}
+	if oldOrganization != nil {
+		for _, clusterRole := range clusterRoles(oldOrganization) {
+			bound[clusterRole] = true
+		}
+	}
+	allowed := func(clusterRole string) bool {
+		return bound[clusterRole] || slices.Contains(v.AllowedClusterRoles, clusterRole)
+	}
+
+	var allErrs field.ErrorList
+	accessPath := field.NewPath("spec", "access")
+	for i, binding := range organization.Spec.Access {
+		if !allowed(binding.ClusterRole) {
+			allErrs = append(allErrs, field.NotSupported(accessPath.Index(i).Child("clusterRole"),
+				binding.ClusterRole, v.AllowedClusterRoles))
+		}
+	}
+	serviceAccountsPath := field.NewPath("spec", "serviceAccounts")
+	for i, serviceAccount := range organization.Spec.ServiceAccounts {
+		for j, clusterRole := range serviceAccount.ClusterRoles {
+			if !allowed(clusterRole) {
+				allErrs = append(allErrs, field.NotSupported(serviceAccountsPath.Index(i).Child("clusterRoles").Index(j),
+					clusterRole, v.AllowedClusterRoles))
+			}
+		}
+	}
+	return allErrs
+}
+
+// clusterRoles returns the ClusterRoles bound by the organization.
+func clusterRoles(organization *securityv1beta1.Organization) []string {
+	var roles []string
+	for _, binding := range organization.Spec.Access {
+		roles = append(roles, binding.ClusterRole)
+	}
+	for _, serviceAccount := range organization.Spec.ServiceAccounts {
+		roles = append(roles, serviceAccount.ClusterRoles...)
+	}
+	return roles
+}
+
+// validateAccess checks that every access binding names a ClusterRole usable
+// in a RoleBinding name and grants it to at least one subject.
+func validateAccess(access []securityv1beta1.AccessBinding, accessPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, binding := range access {
+		bindingPath := accessPath.Index(i)
+		for _, msg := range path.IsValidPathSegmentName(binding.ClusterRole) {
+			allErrs = append(allErrs, field.Invalid(bindingPath.Child("clusterRole"), binding.ClusterRole, msg))
+		}
+		if len(binding.Groups)+len(binding.Users)+len(binding.ServiceAccounts) == 0 {
+			allErrs = append(allErrs, field.Required(bindingPath, "at least one group, user or service account is required"))
+		}
+		for j, serviceAccount := range binding.ServiceAccounts {
+			for _, msg := range validation.IsDNS1123Subdomain(serviceAccount.Name) {
+				allErrs = append(allErrs, field.Invalid(bindingPath.Child("serviceAccounts").Index(j).Child("name"),
+					serviceAccount.Name, msg))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateServiceAccounts checks that every ClusterRole granted to a
+// ServiceAccount is usable in the name of its RoleBinding.
+func validateServiceAccounts(serviceAccounts []securityv1beta1.ServiceAccount, serviceAccountsPath *field.Path) field.ErrorList { //nolint:lll
+	var allErrs field.ErrorList
+	for i, serviceAccount := range serviceAccounts {
+		for j, clusterRole := range serviceAccount.ClusterRoles {
+			clusterRolePath := serviceAccountsPath.Index(i).Child("clusterRoles").Index(j)
+			for _, msg := range path.IsValidPathSegmentName(clusterRole) {
+				allErrs = append(allErrs, field.Invalid(clusterRolePath, clusterRole, msg))
+			}
+			name := naming.ServiceAccountRoleBindingName(serviceAccount.Name, clusterRole)
+			if len(name) > validation.DNS1123SubdomainMaxLength {
+				allErrs = append(allErrs, field.Invalid(clusterRolePath, clusterRole,
+					fmt.Sprintf("RoleBinding name %q %s", name, validation.MaxLenError(validation.DNS1123SubdomainMaxLength))))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateNamespaceCollision rejects organizations whose namespace already
+// exists, unless the organization asks to adopt it. A new organization cannot
+// control an existing namespace yet, not even one labelled for an
+// organization of the same name.
+func (v *OrganizationCustomValidator) validateNamespaceCollision(ctx context.Context, organization *securityv1beta1.Organization, namespaceName string) (field.ErrorList, error) { //nolint:lll
+	if organization.GetAnnotations()[securityv1beta1.AdoptNamespaceAnnotation] == "true" {
+		return nil, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	err := v.Client.Get(ctx, client.ObjectKey{Name: namespaceName}, namespace)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace %s: %w", namespaceName, err)
+	}
+
+	return field.ErrorList{field.Invalid(field.NewPath("metadata", "name"), organization.Name,
+		fmt.Sprintf("namespace %q already exists, set annotation %s=true to adopt it",
+			namespaceName, securityv1beta1.AdoptNamespaceAnnotation))}, nil
+}
+
+func toInvalidError(organization *securityv1beta1.Organization, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(securityv1beta1.GroupVersion.WithKind("Organization").GroupKind(),
+		organization.Name, allErrs)
+}
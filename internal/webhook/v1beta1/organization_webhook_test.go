@@ -0,0 +1,408 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"strings"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+	gomega "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
+	"github.com/giantswarm/organization-operator/internal/naming"
+	"github.com/giantswarm/organization-operator/internal/protection"
+)
+
+var _ = ginkgo.Describe("Organization webhook", func() {
+	var (
+		ctx       context.Context
+		validator *OrganizationCustomValidator
+	)
+
+	newOrganization := func(name string) *securityv1beta1.Organization {
+		return &securityv1beta1.Organization{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+	}
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.Background()
+		validator = &OrganizationCustomValidator{Client: k8sClient}
+	})
+
+	ginkgo.Context("When creating an Organization", func() {
+		ginkgo.It("Should admit a valid Organization", func() {
+			_, err := validator.ValidateCreate(ctx, newOrganization("valid"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject a name producing a namespace longer than 63 characters", func() {
+			_, err := validator.ValidateCreate(ctx, newOrganization(strings.Repeat("a", 60)))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject a name producing an invalid namespace", func() {
+			_, err := validator.ValidateCreate(ctx, newOrganization("invalid.name"))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should validate the namespace rendered from the configured template", func() {
+			template, err := naming.Parse("tenant-{{ .Name }}")
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			validator.NamespaceTemplate = template
+
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "tenant-taken",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
+
+			_, err = validator.ValidateCreate(ctx, newOrganization("taken"))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+			_, err = validator.ValidateCreate(ctx, newOrganization(strings.Repeat("a", 57)))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject templates rendering invalid namespace names", func() {
+			_, err := naming.Parse("Tenant_{{ .Name }}")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+			_, err = naming.Parse("tenant-{{ .Missing }}")
+			gomega.Expect(err).To(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject reserved namespace labels", func() {
+			org := newOrganization("reserved-labels")
+			org.Spec.Namespace.Labels = map[string]string{
+				securityv1beta1.OrganizationLabel: "other",
+			}
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject a name colliding with a namespace not managed by the operator", func() {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "org-collision",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
+
+			_, err := validator.ValidateCreate(ctx, newOrganization("collision"))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should admit a colliding name when the Organization asks to adopt the namespace", func() {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "org-adopt",
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
+
+			org := newOrganization("adopt")
+			org.Annotations = map[string]string{securityv1beta1.AdoptNamespaceAnnotation: "true"}
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject a name matching a namespace labelled for the same Organization", func() {
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "org-managed",
+					Labels: map[string]string{
+						securityv1beta1.OrganizationLabel: "managed",
+						securityv1beta1.ManagedByLabel:    securityv1beta1.ManagedByValue,
+					},
+				},
+			}
+			gomega.Expect(k8sClient.Create(ctx, namespace)).To(gomega.Succeed())
+
+			_, err := validator.ValidateCreate(ctx, newOrganization("managed"))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.Context("When updating an Organization", func() {
+		ginkgo.It("Should admit changes to mutable fields", func() {
+			oldOrg := newOrganization("update")
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Spec.Namespace.Labels = map[string]string{"team": "platform"}
+
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject changes to the fields the namespace name is rendered from", func() {
+			template, err := naming.Parse(`{{ with index .Labels "tier" }}{{ . }}-{{ end }}{{ .Name }}`)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			validator.NamespaceTemplate = template
+
+			oldOrg := newOrganization("update-naming")
+			oldOrg.Labels = map[string]string{"tier": "gold"}
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Labels["tier"] = "silver"
+
+			_, err = validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			newOrg.Labels["tier"] = "gold"
+			newOrg.Labels["team"] = "platform"
+			_, err = validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject invalid namespace annotations", func() {
+			oldOrg := newOrganization("update-invalid")
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Spec.Namespace.Annotations = map[string]string{"not a key": "value"}
+
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject access bindings without subjects", func() {
+			oldOrg := newOrganization("update-access")
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Spec.Access = []securityv1beta1.AccessBinding{{ClusterRole: "view"}}
+
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			newOrg.Spec.Access[0].Groups = []string{"customer:developers"}
+			_, err = validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("When an Organization declares ServiceAccounts", func() {
+		ginkgo.It("Should reject ClusterRoles unusable in a RoleBinding name", func() {
+			org := newOrganization("service-accounts")
+			org.Spec.ServiceAccounts = []securityv1beta1.ServiceAccount{
+				{Name: "ci", ClusterRoles: []string{"view", "a/b"}},
+			}
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			org.Spec.ServiceAccounts[0].ClusterRoles = []string{"view", strings.Repeat("a", 230)}
+			_, err = validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			org.Spec.ServiceAccounts[0].ClusterRoles = []string{"view", "system:aggregate-to-edit"}
+			_, err = validator.ValidateCreate(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("When allowed ClusterRoles are configured", func() {
+		withAccess := func(org *securityv1beta1.Organization, clusterRole string) *securityv1beta1.Organization {
+			org.Spec.Access = []securityv1beta1.AccessBinding{{
+				ClusterRole: clusterRole,
+				Groups:      []string{"customer:developers"},
+			}}
+			return org
+		}
+
+		ginkgo.BeforeEach(func() {
+			validator.AllowedClusterRoles = DefaultAllowedClusterRoles
+		})
+
+		ginkgo.It("Should reject binding ClusterRoles that are not allowed", func() {
+			_, err := validator.ValidateCreate(ctx, withAccess(newOrganization("cluster-admin"), "cluster-admin"))
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			_, err = validator.ValidateCreate(ctx, withAccess(newOrganization("edit"), "edit"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			oldOrg := withAccess(newOrganization("escalate"), "view")
+			newOrg := withAccess(oldOrg.DeepCopy(), "cluster-admin")
+			_, err = validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject granting ClusterRoles that are not allowed to ServiceAccounts", func() {
+			org := newOrganization("service-account-admin")
+			org.Spec.ServiceAccounts = []securityv1beta1.ServiceAccount{
+				{Name: "ci", ClusterRoles: []string{"edit", "cluster-admin"}},
+			}
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			org.Spec.ServiceAccounts[0].ClusterRoles = []string{"edit"}
+			_, err = validator.ValidateCreate(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should admit ClusterRoles that were already bound", func() {
+			legacyOrg := withAccess(newOrganization("legacy-access"), "cluster-admin")
+			updatedOrg := legacyOrg.DeepCopy()
+			updatedOrg.Spec.Access[0].Users = []string{"jane@example.com"}
+			_, err := validator.ValidateUpdate(ctx, legacyOrg, updatedOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("When a minimum pod security level is configured", func() {
+		withEnforce := func(org *securityv1beta1.Organization,
+			level securityv1beta1.PodSecurityLevel) *securityv1beta1.Organization {
+			org.Spec.Namespace.PodSecurity = &securityv1beta1.PodSecurity{Enforce: level}
+			return org
+		}
+
+		ginkgo.BeforeEach(func() {
+			validator.MinimumPodSecurityLevel = securityv1beta1.PodSecurityLevelBaseline
+		})
+
+		ginkgo.It("Should reject creating an Organization enforcing a lower level", func() {
+			org := withEnforce(newOrganization("privileged"), securityv1beta1.PodSecurityLevelPrivileged)
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			org = withEnforce(newOrganization("restricted"), securityv1beta1.PodSecurityLevelRestricted)
+			_, err = validator.ValidateCreate(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject downgrades but admit unchanged levels", func() {
+			oldOrg := withEnforce(newOrganization("downgrade"), securityv1beta1.PodSecurityLevelRestricted)
+			newOrg := withEnforce(oldOrg.DeepCopy(), securityv1beta1.PodSecurityLevelPrivileged)
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			legacyOrg := withEnforce(newOrganization("legacy"), securityv1beta1.PodSecurityLevelPrivileged)
+			updatedOrg := legacyOrg.DeepCopy()
+			updatedOrg.Spec.Namespace.Labels = map[string]string{"team": "platform"}
+			_, err = validator.ValidateUpdate(ctx, legacyOrg, updatedOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject removing the enforced level", func() {
+			oldOrg := withEnforce(newOrganization("unset"), securityv1beta1.PodSecurityLevelRestricted)
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Spec.Namespace.PodSecurity = nil
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			_, err = validator.ValidateCreate(ctx, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should reject clearing the enforced level", func() {
+			oldOrg := withEnforce(newOrganization("cleared"), securityv1beta1.PodSecurityLevelRestricted)
+			newOrg := withEnforce(oldOrg.DeepCopy(), "")
+			newOrg.Spec.Namespace.PodSecurity.Audit = securityv1beta1.PodSecurityLevelRestricted
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+
+		ginkgo.It("Should admit an unset level when the minimum is privileged", func() {
+			validator.MinimumPodSecurityLevel = securityv1beta1.PodSecurityLevelPrivileged
+			oldOrg := withEnforce(newOrganization("unset-privileged"), securityv1beta1.PodSecurityLevelRestricted)
+			newOrg := oldOrg.DeepCopy()
+			newOrg.Spec.Namespace.PodSecurity = nil
+			_, err := validator.ValidateUpdate(ctx, oldOrg, newOrg)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should reject Pod Security labels in the namespace labels", func() {
+			org := newOrganization("pod-security-labels")
+			org.Spec.Namespace.Labels = map[string]string{
+				securityv1beta1.PodSecurityLabelPrefix + "enforce": "privileged",
+			}
+			_, err := validator.ValidateCreate(ctx, org)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+		})
+	})
+
+	ginkgo.Context("When an Organization has a parent", func() {
+		ginkgo.It("Should reject parents that create a cycle", func() {
+			root := newOrganization("cycle-root")
+			gomega.Expect(k8sClient.Create(ctx, root)).To(gomega.Succeed())
+			leaf := newOrganization("cycle-leaf")
+			leaf.Spec.Parent = root.Name
+			gomega.Expect(k8sClient.Create(ctx, leaf)).To(gomega.Succeed())
+
+			updatedRoot := root.DeepCopy()
+			updatedRoot.Spec.Parent = leaf.Name
+			_, err := validator.ValidateUpdate(ctx, root, updatedRoot)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			updatedRoot.Spec.Parent = root.Name
+			_, err = validator.ValidateUpdate(ctx, root, updatedRoot)
+			gomega.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+			orphan := newOrganization("cycle-orphan")
+			orphan.Spec.Parent = "does-not-exist-yet"
+			_, err = validator.ValidateCreate(ctx, orphan)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+
+			ginkgo.By("Denying the deletion of the parent while it has children")
+			_, err = validator.ValidateDelete(ctx, root)
+			gomega.Expect(apierrors.IsForbidden(err)).To(gomega.BeTrue())
+			_, err = validator.ValidateDelete(ctx, leaf)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+
+	ginkgo.Context("When deleting an Organization", func() {
+		ginkgo.BeforeEach(func() {
+			validator.ProtectedKinds = protection.DefaultKinds
+		})
+
+		ginkgo.It("Should deny the deletion while the namespace contains a Cluster", func() {
+			org := newOrganization("protected")
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(protection.DefaultKinds[0])
+			cluster.SetNamespace("org-protected")
+			cluster.SetName("workload")
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+
+			_, err := validator.ValidateDelete(ctx, org)
+			gomega.Expect(apierrors.IsForbidden(err)).To(gomega.BeTrue())
+
+			ginkgo.By("Allowing the deletion with the override annotation")
+			org.Annotations = map[string]string{securityv1beta1.AllowDeletionAnnotation: "true"}
+			_, err = validator.ValidateDelete(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should allow the deletion when the namespace is retained", func() {
+			org := newOrganization("retained")
+			org.Spec.Namespace.DeletionPolicy = securityv1beta1.DeletionPolicyRetain
+			cluster := &unstructured.Unstructured{}
+			cluster.SetGroupVersionKind(protection.DefaultKinds[0])
+			cluster.SetNamespace("org-retained")
+			cluster.SetName("workload")
+			gomega.Expect(k8sClient.Create(ctx, cluster)).To(gomega.Succeed())
+
+			_, err := validator.ValidateDelete(ctx, org)
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+
+		ginkgo.It("Should allow the deletion of an empty namespace", func() {
+			_, err := validator.ValidateDelete(ctx, newOrganization("unprotected"))
+			gomega.Expect(err).NotTo(gomega.HaveOccurred())
+		})
+	})
+})
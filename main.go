@@ -21,6 +21,8 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -30,6 +32,8 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
@@ -37,7 +41,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	securityv1alpha1 "github.com/giantswarm/organization-operator/api/v1alpha1"
+	securityv1beta1 "github.com/giantswarm/organization-operator/api/v1beta1"
 	"github.com/giantswarm/organization-operator/internal/controller"
+	"github.com/giantswarm/organization-operator/internal/naming"
+	"github.com/giantswarm/organization-operator/internal/protection"
+	webhooksecurityv1beta1 "github.com/giantswarm/organization-operator/internal/webhook/v1beta1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -50,6 +58,7 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(securityv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(securityv1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -60,6 +69,17 @@ func main() {
 	var secureMetrics bool
 	var metricsCertPath string
 	var enableHTTP2 bool
+	var enableWebhooks bool
+	var webhookPort int
+	var protectedKindsFlag string
+	var managementNamespacesFlag string
+	var minimumPodSecurityLevelFlag string
+	var namespaceTemplateFlag string
+	var readOnlyClusterRolesFlag string
+	var allowedClusterRolesFlag string
+	var teardownKindsFlag string
+	var deletionTimeout time.Duration
+	var replicationNamespace string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8000", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -71,6 +91,34 @@ func main() {
 		"Path to the directory containing TLS certificate data to be used by the metrics endpoint.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false,
+		"If set, the admission webhooks are served. The conversion webhook is always served, so the webhook "+
+			"server always requires a serving certificate in the webhook cert dir.")
+	flag.IntVar(&webhookPort, "webhook-port", 9443, "The port the webhook server binds to.")
+	flag.StringVar(&protectedKindsFlag, "protected-kinds",
+		strings.Join(protection.FormatKinds(protection.DefaultKinds), ","),
+		"Comma-separated list of Kind.version.group whose objects block the deletion of an organization namespace.")
+	flag.StringVar(&managementNamespacesFlag, "management-namespaces",
+		strings.Join(controller.DefaultManagementNamespaces, ","),
+		"Comma-separated list of namespaces allowed to reach organization namespaces with network isolation.")
+	flag.StringVar(&minimumPodSecurityLevelFlag, "minimum-pod-security-level", "privileged",
+		"The least restrictive pod security level organizations may enforce: privileged, baseline or restricted.")
+	flag.StringVar(&namespaceTemplateFlag, "namespace-template", naming.DefaultTemplate,
+		"Go template rendering the namespace name of new organizations from the Organization, e.g. tenant-{{ .Name }}.")
+	flag.StringVar(&readOnlyClusterRolesFlag, "read-only-cluster-roles",
+		strings.Join(controller.DefaultReadOnlyClusterRoles, ","),
+		"Comma-separated list of ClusterRoles whose access bindings remain while an organization is suspended.")
+	flag.StringVar(&allowedClusterRolesFlag, "allowed-cluster-roles",
+		strings.Join(webhooksecurityv1beta1.DefaultAllowedClusterRoles, ","),
+		"Comma-separated list of ClusterRoles organizations may bind. Empty allows any ClusterRole.")
+	flag.StringVar(&teardownKindsFlag, "teardown-kinds",
+		strings.Join(protection.FormatKinds(controller.DefaultTeardownKinds), ","),
+		"Comma-separated list of Kind.version.group whose objects are deleted before the organization namespace.")
+	flag.DurationVar(&deletionTimeout, "deletion-timeout", 30*time.Minute,
+		"How long deleting an organization may take before it is reported as stuck. Zero disables the check.")
+	flag.StringVar(&replicationNamespace, "replication-namespace", "",
+		"Namespace whose Secrets and ConfigMaps annotated with organization.giantswarm.io/replicate=true are copied "+
+			"into organization namespaces. Empty disables replication.")
 	opts := zap.Options{
 		Development: false,
 	}
@@ -79,6 +127,30 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	protectedKinds, err := protection.ParseKinds(strings.Split(protectedKindsFlag, ","))
+	if err != nil {
+		setupLog.Error(err, "invalid --protected-kinds")
+		os.Exit(1)
+	}
+
+	teardownKinds, err := protection.ParseKinds(strings.Split(teardownKindsFlag, ","))
+	if err != nil {
+		setupLog.Error(err, "invalid --teardown-kinds")
+		os.Exit(1)
+	}
+
+	minimumPodSecurityLevel, err := webhooksecurityv1beta1.ParsePodSecurityLevel(minimumPodSecurityLevelFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid --minimum-pod-security-level")
+		os.Exit(1)
+	}
+
+	namespaceTemplate, err := naming.Parse(namespaceTemplateFlag)
+	if err != nil {
+		setupLog.Error(err, "invalid --namespace-template")
+		os.Exit(1)
+	}
+
 	disableHTTP2 := func(c *tls.Config) {
 		setupLog.Info("disabling http/2")
 		c.NextProtos = []string{"http/1.1"}
@@ -90,11 +162,21 @@ func main() {
 	}
 
 	webhookServer := webhook.NewServer(webhook.Options{
+		Port:    webhookPort,
 		TLSOpts: tlsOpts,
 	})
 
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme: scheme,
+		Cache: cache.Options{
+			ByObject: controller.ReplicationCacheOptions(replicationNamespace),
+		},
+		// Clusters and the other protected and teardown kinds are read as
+		// unstructured objects from the cache, which shares the informer of
+		// the Cluster watch, rather than listed on every reconcile.
+		Client: client.Options{
+			Cache: &client.CacheOptions{Unstructured: true},
+		},
 		Metrics: metricsserver.Options{
 			BindAddress:    metricsAddr,
 			SecureServing:  secureMetrics,
@@ -113,12 +195,36 @@ func main() {
 	}
 
 	if err = (&controller.OrganizationReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:               mgr.GetClient(),
+		Scheme:               mgr.GetScheme(),
+		Recorder:             mgr.GetEventRecorder("organization-operator"),
+		ProtectedKinds:       protectedKinds,
+		ManagementNamespaces: strings.FieldsFunc(managementNamespacesFlag, func(r rune) bool { return r == ',' }),
+		NamespaceTemplate:    namespaceTemplate,
+		ReadOnlyClusterRoles: strings.FieldsFunc(readOnlyClusterRolesFlag, func(r rune) bool { return r == ',' }),
+		TeardownKinds:        teardownKinds,
+		DeletionTimeout:      deletionTimeout,
+		ReplicationNamespace: replicationNamespace,
+		APIReader:            mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Organization")
 		os.Exit(1)
 	}
+	if err = webhooksecurityv1beta1.SetupOrganizationConversionWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create conversion webhook", "webhook", "Organization")
+		os.Exit(1)
+	}
+	if enableWebhooks {
+		if err = webhooksecurityv1beta1.SetupOrganizationWebhookWithManager(mgr, webhooksecurityv1beta1.Options{
+			ProtectedKinds:          protectedKinds,
+			MinimumPodSecurityLevel: minimumPodSecurityLevel,
+			NamespaceTemplate:       namespaceTemplate,
+			AllowedClusterRoles:     strings.FieldsFunc(allowedClusterRolesFlag, func(r rune) bool { return r == ',' }),
+		}); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Organization")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {